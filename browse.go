@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/ssdp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+// Lists the MediaServers on the network, and browses, searches and
+// downloads from their ContentDirectory.
+func browseMain(args []string) {
+	fs := flag.NewFlagSet("browse", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: dms browse [flags]                       list the servers")
+		fmt.Fprintln(os.Stderr, "       dms browse [flags] [OBJECTID]            list a container's children, 0 by default")
+		fmt.Fprintln(os.Stderr, "       dms browse [flags] -o FILE OBJECTID      download one of an item's resources")
+		fs.PrintDefaults()
+	}
+	serverName := fs.String("server", "", "server friendly name, UDN or description URL, needed if there's more than one")
+	mx := fs.Duration("mx", 2*time.Second, "time servers may take to respond to the search, 1-5s")
+	ifName := fs.String("ifname", "", "network interface to search on")
+	metadata := fs.Bool("metadata", false, "show the object itself instead of its children")
+	search := fs.String("search", "", `search the container's descendants with criteria, such as 'upnp:class derivedfrom "object.item.audioItem"'`)
+	start := fs.Uint("start", 0, "index of the first object to show")
+	count := fs.Uint("count", 0, "most objects to show, 0 for as many as the server gives")
+	raw := fs.Bool("raw", false, "print the DIDL-Lite as the server sent it")
+	output := fs.String("o", "", "download a resource of the item to this file, - for stdout")
+	resIndex := fs.Int("res", 0, "index of the resource to download")
+	fs.Parse(args)
+	if fs.NArg() > 1 || *output != "" && fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	c := &ssdp.Client{}
+	if *ifName != "" {
+		ifi, err := net.InterfaceByName(*ifName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		c.Interface = ifi
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+	if fs.NArg() == 0 && *serverName == "" {
+		listServers(ctx, c, *mx)
+		return
+	}
+	ms, err := dmc.FindMediaServer(ctx, c, *mx, *serverName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	id := "0"
+	if fs.NArg() == 1 {
+		id = fs.Arg(0)
+	}
+	var res dmc.BrowseResult
+	switch {
+	case *output != "":
+		res, err = ms.Browse(ctx, id, dmc.BrowseMetadata, 0, 0)
+	case *search != "":
+		res, err = ms.Search(ctx, id, *search, *start, *count)
+	case *metadata:
+		res, err = ms.Browse(ctx, id, dmc.BrowseMetadata, 0, 0)
+	default:
+		res, err = ms.Browse(ctx, id, dmc.BrowseDirectChildren, *start, *count)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *output != "" {
+		items := res.Result.Items()
+		if len(items) != 1 {
+			log.Fatalf("%s isn't an item", id)
+		}
+		if err := download(ctx, items[0], *resIndex, *output); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *raw {
+		fmt.Println(res.ResultXML)
+		return
+	}
+	printObjects(os.Stdout, res.Result)
+	if !*metadata {
+		fmt.Printf("%d of %d, from %d\n", res.NumberReturned, res.TotalMatches, *start)
+	}
+}
+
+func listServers(ctx context.Context, c *ssdp.Client, mx time.Duration) {
+	mss, err := dmc.DiscoverMediaServers(ctx, c, mx)
+	if err != nil && err != context.Canceled {
+		log.Fatal(err)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tUDN\tLOCATION")
+	for _, ms := range mss {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", ms.FriendlyName, ms.UDN, ms.Location)
+	}
+	w.Flush()
+}
+
+// Prints each object's title, class and ID, and each item's resources.
+// Containers are marked with a slash.
+func printObjects(w io.Writer, d upnpav.DIDLLite) {
+	for _, obj := range d.Objects {
+		switch o := obj.(type) {
+		case upnpav.Container:
+			fmt.Fprintf(w, "%s/  (%s, %d children)\n", o.Title, o.Class, o.ChildCount)
+			fmt.Fprintf(w, "    id %s, parent %s\n", o.ID, o.ParentID)
+		case upnpav.Item:
+			fmt.Fprintf(w, "%s  (%s)\n", o.Title, o.Class)
+			fmt.Fprintf(w, "    id %s, parent %s\n", o.ID, o.ParentID)
+			for i, r := range o.Res {
+				fmt.Fprintf(w, "    res %d: %s%s\n        %s\n", i, r.ProtocolInfo, resourceDetails(r), r.URL)
+			}
+		}
+	}
+}
+
+// Returns the resource's notable attributes, such as its size.
+func resourceDetails(r upnpav.Resource) string {
+	var ss []string
+	add := func(name string, v interface{}) {
+		if v != "" && v != uint(0) && v != uint64(0) {
+			ss = append(ss, fmt.Sprintf("%s %v", name, v))
+		}
+	}
+	add("size", r.Size)
+	add("duration", r.Duration)
+	add("resolution", r.Resolution)
+	add("bitrate", r.Bitrate)
+	if len(ss) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(ss, ", ") + ")"
+}
+
+// Downloads one of an item's resources to a file, or stdout for "-".
+func download(ctx context.Context, item upnpav.Item, index int, name string) error {
+	if index < 0 || index >= len(item.Res) {
+		return fmt.Errorf("%s has %d resources", item.ID, len(item.Res))
+	}
+	req, err := http.NewRequest("GET", item.Res[index].URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status %q", req.URL, resp.Status)
+	}
+	f := os.Stdout
+	if name != "-" {
+		if f, err = os.Create(name); err != nil {
+			return err
+		}
+	}
+	n, err := io.Copy(f, resp.Body)
+	if name == "-" {
+		return err
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		log.Printf("downloaded %d bytes of %q to %s", n, item.Title, name)
+	}
+	return err
+}
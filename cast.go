@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/dlna/dms"
+	"github.com/anacrolix/dms/ssdp"
+)
+
+// Has a MediaRenderer play a file, serving it until playback ends. Without a
+// file, controls what the renderer is already playing.
+func castMain(args []string) {
+	fs := flag.NewFlagSet("cast", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: dms cast [flags] FILE")
+		fmt.Fprintln(os.Stderr, "       dms cast [flags] -play|-pause|-stop|-seek POS|-volume N|-status")
+		fs.PrintDefaults()
+	}
+	rendererName := fs.String("renderer", "", "renderer friendly name, UDN or description URL, needed if there's more than one")
+	mx := fs.Duration("mx", 2*time.Second, "time renderers may take to respond to the search, 1-5s")
+	ifName := fs.String("ifname", "", "network interface to search on")
+	httpAddr := fs.String("http", "", "address to serve the file on, any port by default")
+	noTranscode := fs.Bool("noTranscode", false, "disable transcoding")
+	noProbe := fs.Bool("noProbe", false, "disable media probing with ffprobe")
+	interval := fs.Duration("interval", 2*time.Second, "interval between polls of the playback state")
+	play := fs.Bool("play", false, "resume playback")
+	pause := fs.Bool("pause", false, "pause playback")
+	stop := fs.Bool("stop", false, "stop playback")
+	seek := fs.String("seek", "", "seek to a position, as H:MM:SS")
+	volume := fs.Int("volume", -1, "set the volume, normally 0-100")
+	status := fs.Bool("status", false, "show the playback state")
+	fs.Parse(args)
+	control := *play || *pause || *stop || *seek != "" || *status
+	if fs.NArg() > 1 || fs.NArg() == 1 && control || fs.NArg() == 0 && !control && *volume < 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	var seekPos time.Duration
+	if *seek != "" {
+		var err error
+		if seekPos, err = dmc.ParseTime(*seek); err != nil {
+			log.Fatal(err)
+		}
+	}
+	c := &ssdp.Client{}
+	var ifaces []net.Interface
+	if *ifName != "" {
+		ifi, err := net.InterfaceByName(*ifName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		c.Interface = ifi
+		ifaces = []net.Interface{*ifi}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+	r, err := dmc.Find(ctx, c, *mx, *rendererName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *volume >= 0 {
+		if err := r.SetVolume(ctx, uint(*volume)); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if fs.NArg() == 0 {
+		controlRenderer(ctx, r, *play, *pause, *stop, *seek != "", seekPos, *status)
+		return
+	}
+
+	file, err := filepath.Abs(fs.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv, err := newCastServer(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv.Interfaces = ifaces
+	srv.NoTranscode = *noTranscode
+	srv.NoProbe = *noProbe
+	if *httpAddr != "" {
+		if srv.HTTPConn, err = net.Listen("tcp", *httpAddr); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := srv.Init(); err != nil {
+		log.Fatal(err)
+	}
+	go func() {
+		if err := srv.Serve(); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer srv.Close()
+	if err := srv.Cast(ctx, r, "/"+filepath.Base(file)); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("casting %s to %q\n", file, r.FriendlyName)
+	var lastState string
+	err = r.Wait(ctx, *interval, func(ti dmc.TransportInfo, pi dmc.PositionInfo) {
+		if ti.State != lastState {
+			fmt.Printf("%s %s/%s\n", ti.State, dmc.FormatTime(pi.RelTime), dmc.FormatTime(pi.Duration))
+			lastState = ti.State
+		}
+	})
+	if err == context.Canceled {
+		// Playback can't go on without the server.
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := r.Stop(stopCtx); err != nil {
+			log.Print(err)
+		}
+		return
+	}
+	if err != nil {
+		log.Print(err)
+	}
+}
+
+// Returns a server of only the media file, for a renderer to fetch it from.
+// Nothing else in its directory is served.
+func newCastServer(file string) (*dms.Server, error) {
+	fi, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	mt, err := dms.MimeTypeByPath(file)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.Mode().IsRegular() || !mt.IsMedia() {
+		return nil, fmt.Errorf("%s: not a media file", file)
+	}
+	return &dms.Server{
+		FriendlyName:   "dms cast",
+		RootObjectPath: filepath.Dir(file),
+		AccessRules:    []dms.AccessRule{{Paths: []string{"/" + filepath.Base(file)}}},
+		NoSSDP:         true,
+	}, nil
+}
+
+func controlRenderer(ctx context.Context, r *dmc.Renderer, play, pause, stop, seek bool, pos time.Duration, status bool) {
+	var err error
+	if seek {
+		err = r.Seek(ctx, pos)
+	}
+	if err == nil && play {
+		err = r.Play(ctx)
+	}
+	if err == nil && pause {
+		err = r.Pause(ctx)
+	}
+	if err == nil && stop {
+		err = r.Stop(ctx)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !status {
+		return
+	}
+	ti, err := r.TransportInfo(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pi, err := r.PositionInfo(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s: %s %s/%s %s\n", r.FriendlyName, ti.State, dmc.FormatTime(pi.RelTime), dmc.FormatTime(pi.Duration), pi.URI)
+	if v, err := r.Volume(ctx); err == nil {
+		fmt.Printf("volume %d\n", v)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCastServerServesOnlyTheFile(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"movie.mp4":        "movie",
+		"other.mp4":        "other",
+		".ssh/id_rsa":      "key",
+		"notes/secret.txt": "secret",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, file := range []string{filepath.Join(dir, "notes", "secret.txt"), filepath.Join(dir, "notes")} {
+		if _, err := newCastServer(file); err == nil {
+			t.Errorf("%s: cast a non-media file", file)
+		}
+	}
+	srv, err := newCastServer(filepath.Join(dir, "movie.mp4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.NoProbe = true
+	if srv.HTTPConn, err = net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.Init(); err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve()
+	defer srv.Close()
+	get := func(target string) (int, string) {
+		resp, err := http.Get("http://" + srv.HTTPConn.Addr().String() + target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(b)
+	}
+	if code, body := get("/res?path=%2Fmovie.mp4"); code != http.StatusOK || body != "movie" {
+		t.Fatalf("got %d %q", code, body)
+	}
+	for _, p := range []string{"%2Fother.mp4", "%2F.ssh%2Fid_rsa", "%2Fnotes%2Fsecret.txt"} {
+		if code, _ := get("/res?path=" + p); code != http.StatusNotFound {
+			t.Errorf("%s: got %d", p, code)
+		}
+	}
+	if code, body := get("/api/v1/objects/0/children"); code != http.StatusOK || strings.Contains(body, "other") || strings.Contains(body, "notes") || !strings.Contains(body, "movie.mp4") {
+		t.Errorf("got %d %s", code, body)
+	}
+}
@@ -0,0 +1,213 @@
+// The dms web UI. It browses the library through the JSON API, and plays
+// items in the browser. The location hash holds the view: #<id> for a
+// container, or #search/<text>.
+(function () {
+  "use strict";
+
+  // Relative, so that virtual servers serve the UI below their paths.
+  var api = "api/v1";
+  var pageSize = 100;
+  var $ = function (id) {
+    return document.getElementById(id);
+  };
+
+  function getJSON(url) {
+    return fetch(url).then(function (resp) {
+      if (!resp.ok) {
+        return resp.text().then(function (text) {
+          throw new Error(text || resp.statusText);
+        });
+      }
+      return resp.json();
+    });
+  }
+
+  function objectURL(id) {
+    return api + "/objects/" + encodeURIComponent(id);
+  }
+
+  function setStatus(text) {
+    $("status").textContent = text;
+  }
+
+  // Returns the object's ancestors and itself, from the root.
+  function ancestry(id) {
+    var chain = [];
+    function up(id) {
+      return getJSON(objectURL(id)).then(function (o) {
+        chain.unshift(o);
+        if (o.id === "0" || o.parentId === "-1" || o.parentId === "") {
+          return chain;
+        }
+        return up(o.parentId);
+      });
+    }
+    return up(id);
+  }
+
+  function showCrumbs(chain) {
+    var nav = $("crumbs");
+    nav.textContent = "";
+    chain.forEach(function (o) {
+      var a = document.createElement("a");
+      a.href = "#" + encodeURIComponent(o.id);
+      a.textContent = o.id === "0" ? "Home" : o.title;
+      nav.appendChild(a);
+    });
+  }
+
+  // Returns the resources the media element for the item's class can play.
+  function playable(o) {
+    var kind = o.class.split(".")[2];
+    return (o.resources || []).filter(function (r) {
+      return r.mimeType.indexOf(kind + "/") === 0;
+    });
+  }
+
+  // Picks the first resource the browser says it can play. The original
+  // file comes first, and then the transcodes.
+  function pickResource(el, resources) {
+    for (var i = 0; i < resources.length; i++) {
+      if (!el.canPlayType || el.canPlayType(resources[i].mimeType) !== "") {
+        return resources[i];
+      }
+    }
+    return resources[0];
+  }
+
+  function play(o) {
+    var kind = o.class.split(".")[2];
+    var resources = playable(o);
+    if (resources.length === 0) {
+      return;
+    }
+    var tag = { video: "video", audio: "audio", image: "img" }[kind];
+    if (!tag) {
+      window.open(resources[0].url);
+      return;
+    }
+    var el = document.createElement(tag);
+    var r = tag === "img" ? resources[0] : pickResource(el, resources);
+    el.src = r.url;
+    if (tag !== "img") {
+      el.controls = true;
+      el.autoplay = true;
+      if (o.albumArtUri && tag === "video") {
+        el.poster = o.albumArtUri;
+      }
+    }
+    var media = $("player-media");
+    stop();
+    media.appendChild(el);
+    $("player-title").textContent = o.title;
+    $("player").hidden = false;
+    window.scrollTo(0, 0);
+  }
+
+  function stop() {
+    var media = $("player-media");
+    var el = media.firstChild;
+    if (el && el.pause) {
+      el.pause();
+      el.removeAttribute("src");
+      el.load();
+    }
+    media.textContent = "";
+    $("player").hidden = true;
+  }
+
+  var icons = { video: "🎬", audio: "🎵", image: "🖼" };
+
+  function tile(o) {
+    var li = document.createElement("li");
+    var a = document.createElement("a");
+    var thumb = document.createElement("div");
+    thumb.className = "thumb";
+    if (o.container) {
+      a.href = "#" + encodeURIComponent(o.id);
+      thumb.textContent = "📁";
+    } else {
+      a.href = "javascript:";
+      a.addEventListener("click", function (e) {
+        e.preventDefault();
+        play(o);
+      });
+      thumb.textContent = icons[o.class.split(".")[2]] || "📄";
+      if (o.albumArtUri) {
+        var img = document.createElement("img");
+        img.loading = "lazy";
+        img.alt = "";
+        img.onload = function () {
+          thumb.textContent = "";
+          thumb.appendChild(img);
+        };
+        img.src = o.albumArtUri;
+      }
+    }
+    var title = document.createElement("div");
+    title.className = "title";
+    title.textContent = o.title;
+    a.appendChild(thumb);
+    a.appendChild(title);
+    li.appendChild(a);
+    return li;
+  }
+
+  // Shows the pages of objects at url, from start.
+  function list(url, start) {
+    var more = $("more");
+    more.hidden = true;
+    if (start === 0) {
+      $("grid").textContent = "";
+    }
+    setStatus("Loading…");
+    var sep = url.indexOf("?") < 0 ? "?" : "&";
+    return getJSON(url + sep + "start=" + start + "&count=" + pageSize).then(function (page) {
+      page.objects.forEach(function (o) {
+        $("grid").appendChild(tile(o));
+      });
+      var shown = page.start + page.objects.length;
+      setStatus(page.total === 0 ? "Nothing here." : "");
+      if (shown < page.total) {
+        more.hidden = false;
+        more.onclick = function () {
+          list(url, shown);
+        };
+      }
+    });
+  }
+
+  function route() {
+    var hash = decodeURIComponent(location.hash.slice(1));
+    var done;
+    if (hash.indexOf("search/") === 0) {
+      var q = hash.slice("search/".length);
+      document.querySelector("#search input").value = q;
+      showCrumbs([{ id: "0", title: "" }]);
+      var a = document.createElement("a");
+      a.textContent = "Search for “" + q + "”";
+      $("crumbs").appendChild(a);
+      done = list(api + "/search?q=" + encodeURIComponent(q), 0);
+    } else {
+      var id = hash || "0";
+      done = ancestry(id).then(function (chain) {
+        showCrumbs(chain);
+        return list(objectURL(id) + "/children", 0);
+      });
+    }
+    done.catch(function (err) {
+      setStatus(err.message);
+    });
+  }
+
+  $("search").addEventListener("submit", function (e) {
+    e.preventDefault();
+    var q = this.q.value.trim();
+    if (q) {
+      location.hash = "search/" + encodeURIComponent(q);
+    }
+  });
+  $("player-close").addEventListener("click", stop);
+  window.addEventListener("hashchange", route);
+  route();
+})();
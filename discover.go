@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	"github.com/anacrolix/dms/ssdp"
+	"github.com/anacrolix/dms/upnp"
+)
+
+// Lists UPnP devices and services on the network.
+func discoverMain(args []string) {
+	fs := flag.NewFlagSet("discover", flag.ExitOnError)
+	st := fs.String("st", ssdp.RootDevice, "search target, such as ssdp:all or a device or service type")
+	mx := fs.Duration("mx", 3*time.Second, "time devices may take to respond, 1-5s")
+	ifName := fs.String("ifname", "", "network interface to search on")
+	ipv6 := fs.Bool("ipv6", false, "search over IPv6")
+	watch := fs.Bool("watch", false, "keep listening, and report devices as they come and go")
+	fs.Parse(args)
+	if fs.NArg() != 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	c := &ssdp.Client{IPv6: *ipv6}
+	if *ifName != "" {
+		ifi, err := net.InterfaceByName(*ifName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		c.Interface = ifi
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+	if *watch {
+		watchDevices(ctx, c, *st)
+		return
+	}
+	var found []ssdp.Advertisement
+	err := c.Search(ctx, *st, *mx, func(a ssdp.Advertisement) {
+		found = append(found, a)
+	})
+	if err != nil && err != context.Canceled {
+		log.Fatal(err)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tLOCATION\tUSN")
+	for _, a := range found {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", friendlyName(a.Location), a.Type, a.Location, a.USN)
+	}
+	w.Flush()
+}
+
+func watchDevices(ctx context.Context, c *ssdp.Client, st string) {
+	r := ssdp.NewRegistry()
+	r.OnAdd = func(e ssdp.RegistryEntry) {
+		fmt.Printf("+ %s %s %s %q\n", e.USN, e.Type, e.Location, friendlyName(e.Location))
+	}
+	r.OnRemove = func(e ssdp.RegistryEntry) {
+		fmt.Printf("- %s %s\n", e.USN, e.Type)
+	}
+	if err := r.Watch(ctx, c, st, time.Minute); err != nil && err != context.Canceled {
+		log.Fatal(err)
+	}
+}
+
+// Fetches the friendly name from a device description, returning an empty
+// string if that fails.
+func friendlyName(location string) string {
+	if location == "" {
+		return ""
+	}
+	client := http.Client{Timeout: 2 * time.Second}
+	resp, err := client.Get(location)
+	if err != nil {
+		return ""
+	}
+	defer resp.Body.Close()
+	var root struct {
+		Device upnp.Device `xml:"device"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&root); err != nil {
+		return ""
+	}
+	return root.Device.FriendlyName
+}
@@ -0,0 +1,550 @@
+// Package dmc is a DLNA digital media controller: a control point that finds
+// UPnP AV MediaRenderers, and has them play, pause, seek and so on, and that
+// browses MediaServers.
+package dmc
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anacrolix/dms/soap"
+	"github.com/anacrolix/dms/ssdp"
+	"github.com/anacrolix/dms/upnp"
+)
+
+const (
+	MediaRendererType     = "urn:schemas-upnp-org:device:MediaRenderer:1"
+	AVTransportType       = "urn:schemas-upnp-org:service:AVTransport:1"
+	RenderingControlType  = "urn:schemas-upnp-org:service:RenderingControl:1"
+	ConnectionManagerType = "urn:schemas-upnp-org:service:ConnectionManager:1"
+)
+
+// Transport states, as returned by GetTransportInfo.
+const (
+	Stopped        = "STOPPED"
+	Playing        = "PLAYING"
+	Transitioning  = "TRANSITIONING"
+	PausedPlayback = "PAUSED_PLAYBACK"
+	NoMediaPresent = "NO_MEDIA_PRESENT"
+)
+
+// Limits the size of device descriptions.
+const maxDescriptionSize = 1 << 20
+
+// Time allowed for a renderer to start playing, before Wait gives up.
+var startTimeout = 30 * time.Second
+
+var ErrNoRenderingControl = errors.New("renderer has no RenderingControl service")
+
+var ErrNoConnectionManager = errors.New("renderer has no ConnectionManager service")
+
+type service struct {
+	urn         string
+	controlURL  string
+	eventSubURL string
+}
+
+// Device identifies a device found on the network.
+type Device struct {
+	FriendlyName string
+	UDN          string
+	// The URL of the device description.
+	Location string
+}
+
+func (d *Device) device() *Device {
+	return d
+}
+
+// A MediaRenderer, and the control URLs of its services.
+type Renderer struct {
+	Device
+	// Used for actions, defaults to soap.DefaultClient.
+	Client *soap.Client
+
+	avTransport       service
+	renderingControl  service
+	connectionManager service
+}
+
+// TransportInfo is the result of GetTransportInfo.
+type TransportInfo struct {
+	State  string
+	Status string
+	Speed  string
+}
+
+// PositionInfo is the result of GetPositionInfo. Times the renderer doesn't
+// report are zero.
+type PositionInfo struct {
+	Track    uint
+	Duration time.Duration
+	URI      string
+	Metadata string
+	RelTime  time.Duration
+}
+
+// Returns the part of a device or service type that names it, such as
+// "MediaRenderer", ignoring the version.
+func typeName(t string) string {
+	ss := strings.Split(t, ":")
+	if len(ss) != 5 {
+		return ""
+	}
+	return ss[3]
+}
+
+// Returns the first device of the given type, searching embedded devices
+// too.
+func findDevice(d *upnp.Device, name string) *upnp.Device {
+	if typeName(d.DeviceType) == name {
+		return d
+	}
+	for i := range d.DeviceList {
+		if ret := findDevice(&d.DeviceList[i], name); ret != nil {
+			return ret
+		}
+	}
+	return nil
+}
+
+// Fetches the device description at location, and returns the first device
+// of the named type in it, and the base for its relative URLs.
+func fetchDevice(ctx context.Context, location, name string) (*upnp.Device, *url.URL, error) {
+	req, err := http.NewRequest("GET", location, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("%s: unexpected status %q", location, resp.Status)
+	}
+	// The namespace isn't checked, as not every device gets it right.
+	var desc struct {
+		URLBase string      `xml:"URLBase"`
+		Device  upnp.Device `xml:"device"`
+	}
+	if err := xml.NewDecoder(io.LimitReader(resp.Body, maxDescriptionSize)).Decode(&desc); err != nil {
+		return nil, nil, fmt.Errorf("%s: %s", location, err)
+	}
+	base, err := url.Parse(location)
+	if err != nil {
+		return nil, nil, err
+	}
+	if desc.URLBase != "" {
+		if base, err = base.Parse(desc.URLBase); err != nil {
+			return nil, nil, err
+		}
+	}
+	d := findDevice(&desc.Device, name)
+	if d == nil {
+		return nil, nil, fmt.Errorf("%s: no %s device", location, name)
+	}
+	return d, base, nil
+}
+
+// Returns the service with its URLs resolved against base.
+func newService(base *url.URL, s upnp.Service) (ret service, err error) {
+	u, err := base.Parse(s.ControlURL)
+	if err != nil {
+		err = fmt.Errorf("bad control URL for %s: %s", s.ServiceType, err)
+		return
+	}
+	ret = service{urn: strings.TrimSpace(s.ServiceType), controlURL: u.String()}
+	// Events are optional.
+	if s.EventSubURL != "" {
+		if u, err := base.Parse(s.EventSubURL); err == nil {
+			ret.eventSubURL = u.String()
+		}
+	}
+	return
+}
+
+// Calls an action, returning the output arguments by name.
+func (s service) call(ctx context.Context, c *soap.Client, action string, args ...soap.Arg) (map[string]string, error) {
+	if c == nil {
+		c = soap.DefaultClient
+	}
+	out, err := c.Call(ctx, s.controlURL, s.urn, action, args)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]string, len(out))
+	for _, a := range out {
+		ret[a.XMLName.Local] = a.Value
+	}
+	return ret, nil
+}
+
+// NewRenderer fetches the device description at location, and returns the
+// MediaRenderer it describes. The renderer may be embedded in another
+// device.
+func NewRenderer(ctx context.Context, location string) (*Renderer, error) {
+	d, base, err := fetchDevice(ctx, location, "MediaRenderer")
+	if err != nil {
+		return nil, err
+	}
+	r := &Renderer{Device: Device{d.FriendlyName, d.UDN, location}}
+	for _, s := range d.ServiceList {
+		var dest *service
+		switch typeName(s.ServiceType) {
+		case "AVTransport":
+			dest = &r.avTransport
+		case "RenderingControl":
+			dest = &r.renderingControl
+		case "ConnectionManager":
+			dest = &r.connectionManager
+		default:
+			continue
+		}
+		if *dest, err = newService(base, s); err != nil {
+			return nil, fmt.Errorf("%s: %s", location, err)
+		}
+	}
+	if r.avTransport.controlURL == "" {
+		return nil, fmt.Errorf("%s: renderer has no AVTransport service", location)
+	}
+	return r, nil
+}
+
+// Returns the distinct locations of the devices found by a search for st.
+func searchLocations(ctx context.Context, c *ssdp.Client, st string, mx time.Duration) (ret []string, err error) {
+	if c == nil {
+		c = &ssdp.Client{}
+	}
+	seen := make(map[string]bool)
+	err = c.Search(ctx, st, mx, func(a ssdp.Advertisement) {
+		if a.Location != "" && !seen[a.Location] {
+			seen[a.Location] = true
+			ret = append(ret, a.Location)
+		}
+	})
+	return
+}
+
+// Searches for devices of type st, and gets each one found, leaving out
+// those that can't be got. They're ordered by location.
+func discover[T any](ctx context.Context, c *ssdp.Client, st string, mx time.Duration, get func(context.Context, string) (T, error)) ([]T, error) {
+	locations, err := searchLocations(ctx, c, st, mx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(locations)
+	var (
+		wg  sync.WaitGroup
+		got = make([]T, len(locations))
+		ok  = make([]bool, len(locations))
+	)
+	for i, l := range locations {
+		wg.Add(1)
+		go func(i int, l string) {
+			defer wg.Done()
+			d, err := get(ctx, l)
+			if err != nil {
+				log.Printf("error getting %s: %s", typeName(st), err)
+				return
+			}
+			got[i], ok[i] = d, true
+		}(i, l)
+	}
+	wg.Wait()
+	var ret []T
+	for i := range got {
+		if ok[i] {
+			ret = append(ret, got[i])
+		}
+	}
+	return ret, nil
+}
+
+// Discover searches for MediaRenderers with the given client, which may be
+// nil. Renderers whose descriptions can't be fetched are left out.
+func Discover(ctx context.Context, c *ssdp.Client, mx time.Duration) ([]*Renderer, error) {
+	return discover(ctx, c, MediaRendererType, mx, NewRenderer)
+}
+
+// Match reports whether the device is the one named, by UDN, with or
+// without the "uuid:" prefix, by location, or by friendly name ignoring
+// case.
+func (d *Device) Match(name string) bool {
+	return name == d.UDN ||
+		"uuid:"+name == d.UDN ||
+		name == d.Location ||
+		strings.EqualFold(name, d.FriendlyName)
+}
+
+// Returns the device of type st named, as by Match, calling it a noun in
+// errors. A URL is taken as the device's location, and isn't searched for.
+// If name is empty, there must be only one device.
+func find[T interface{ device() *Device }](ctx context.Context, c *ssdp.Client, st string, mx time.Duration, name, noun string, get func(context.Context, string) (T, error)) (ret T, err error) {
+	if strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://") {
+		return get(ctx, name)
+	}
+	ds, err := discover(ctx, c, st, mx, get)
+	if err != nil {
+		return
+	}
+	if name == "" {
+		switch len(ds) {
+		case 0:
+			err = fmt.Errorf("no %ss found", noun)
+			return
+		case 1:
+			return ds[0], nil
+		}
+		var names []string
+		for _, d := range ds {
+			names = append(names, strconv.Quote(d.device().FriendlyName))
+		}
+		err = fmt.Errorf("%d %ss found, choose one of %s", len(ds), noun, strings.Join(names, ", "))
+		return
+	}
+	for _, d := range ds {
+		if d.device().Match(name) {
+			return d, nil
+		}
+	}
+	err = fmt.Errorf("%s %q not found", noun, name)
+	return
+}
+
+// Find returns the renderer named, as by Match. A URL is taken as the
+// renderer's location, and isn't searched for. If name is empty, there must
+// be only one renderer.
+func Find(ctx context.Context, c *ssdp.Client, mx time.Duration, name string) (*Renderer, error) {
+	return find(ctx, c, MediaRendererType, mx, name, "renderer", NewRenderer)
+}
+
+// LocalIP returns the local address used to reach the renderer, which is
+// where it should be told to fetch media from.
+func (r *Renderer) LocalIP() (net.IP, error) {
+	u, err := url.Parse(r.Location)
+	if err != nil {
+		return nil, err
+	}
+	port := u.Port()
+	if port == "" {
+		port = "80"
+	}
+	// Nothing is sent, this just picks the route.
+	conn, err := net.Dial("udp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).IP, nil
+}
+
+func (r *Renderer) call(ctx context.Context, s service, action string, args ...soap.Arg) (map[string]string, error) {
+	if s.controlURL == "" {
+		return nil, ErrNoRenderingControl
+	}
+	// Only instance 0 is used, it's the only one renderers without
+	// ConnectionManager.PrepareForConnection have.
+	args = append([]soap.Arg{soap.NewArg("InstanceID", "0")}, args...)
+	return s.call(ctx, r.Client, action, args...)
+}
+
+// SetAVTransportURI sets the media to play. The metadata is a DIDL-Lite
+// document describing it, which some renderers require.
+func (r *Renderer) SetAVTransportURI(ctx context.Context, uri, metadata string) error {
+	_, err := r.call(ctx, r.avTransport, "SetAVTransportURI",
+		soap.NewArg("CurrentURI", uri),
+		soap.NewArg("CurrentURIMetaData", metadata))
+	return err
+}
+
+// Play starts or resumes playback at normal speed.
+func (r *Renderer) Play(ctx context.Context) error {
+	_, err := r.call(ctx, r.avTransport, "Play", soap.NewArg("Speed", "1"))
+	return err
+}
+
+func (r *Renderer) Pause(ctx context.Context) error {
+	_, err := r.call(ctx, r.avTransport, "Pause")
+	return err
+}
+
+func (r *Renderer) Stop(ctx context.Context) error {
+	_, err := r.call(ctx, r.avTransport, "Stop")
+	return err
+}
+
+// Seek moves to a position in the current track.
+func (r *Renderer) Seek(ctx context.Context, pos time.Duration) error {
+	_, err := r.call(ctx, r.avTransport, "Seek",
+		soap.NewArg("Unit", "REL_TIME"),
+		soap.NewArg("Target", FormatTime(pos)))
+	return err
+}
+
+func (r *Renderer) TransportInfo(ctx context.Context) (ret TransportInfo, err error) {
+	out, err := r.call(ctx, r.avTransport, "GetTransportInfo")
+	if err != nil {
+		return
+	}
+	ret.State = out["CurrentTransportState"]
+	ret.Status = out["CurrentTransportStatus"]
+	ret.Speed = out["CurrentSpeed"]
+	return
+}
+
+func (r *Renderer) PositionInfo(ctx context.Context) (ret PositionInfo, err error) {
+	out, err := r.call(ctx, r.avTransport, "GetPositionInfo")
+	if err != nil {
+		return
+	}
+	track, _ := strconv.ParseUint(out["Track"], 10, 32)
+	ret.Track = uint(track)
+	ret.Duration, _ = ParseTime(out["TrackDuration"])
+	ret.URI = out["TrackURI"]
+	ret.Metadata = out["TrackMetaData"]
+	ret.RelTime, _ = ParseTime(out["RelTime"])
+	return
+}
+
+// Volume returns the master volume, normally 0 to 100.
+func (r *Renderer) Volume(ctx context.Context) (uint, error) {
+	out, err := r.call(ctx, r.renderingControl, "GetVolume", soap.NewArg("Channel", "Master"))
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseUint(strings.TrimSpace(out["CurrentVolume"]), 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("bad volume: %s", err)
+	}
+	return uint(v), nil
+}
+
+func (r *Renderer) SetVolume(ctx context.Context, volume uint) error {
+	_, err := r.call(ctx, r.renderingControl, "SetVolume",
+		soap.NewArg("Channel", "Master"),
+		soap.NewArg("DesiredVolume", strconv.FormatUint(uint64(volume), 10)))
+	return err
+}
+
+// ProtocolInfo returns the protocol infos of the media the renderer can play,
+// its ConnectionManager's sink list, such as
+// "http-get:*:video/mp4:DLNA.ORG_PN=AVC_MP4_BL_CIF15_AAC_520".
+func (r *Renderer) ProtocolInfo(ctx context.Context) (sink []string, err error) {
+	if r.connectionManager.controlURL == "" {
+		return nil, ErrNoConnectionManager
+	}
+	out, err := r.connectionManager.call(ctx, r.Client, "GetProtocolInfo")
+	if err != nil {
+		return
+	}
+	for _, pi := range strings.Split(out["Sink"], ",") {
+		if pi = strings.TrimSpace(pi); pi != "" {
+			sink = append(sink, pi)
+		}
+	}
+	return
+}
+
+// Wait polls the renderer every interval until playback ends, calling
+// progress, if it's not nil, with each poll's results. Playback has ended
+// when the renderer stops after having played. It's an error if it doesn't
+// start playing in time.
+func (r *Renderer) Wait(ctx context.Context, interval time.Duration, progress func(TransportInfo, PositionInfo)) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	started := false
+	startDeadline := time.Now().Add(startTimeout)
+	for {
+		ti, err := r.TransportInfo(ctx)
+		if err != nil {
+			return err
+		}
+		pi, err := r.PositionInfo(ctx)
+		if err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(ti, pi)
+		}
+		switch ti.State {
+		case Playing, PausedPlayback:
+			started = true
+		case Stopped, NoMediaPresent:
+			if started {
+				return nil
+			}
+			if time.Now().After(startDeadline) {
+				return fmt.Errorf("playback didn't start: %s %s", ti.State, ti.Status)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// FormatTime formats a duration as H+:MM:SS, as used for track positions.
+// Fractions of seconds are dropped, as not every renderer accepts them.
+func FormatTime(d time.Duration) string {
+	s := int64(d / time.Second)
+	return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+// ParseTime parses a track position or duration of the form H+:MM:SS[.F+]
+// or H+:MM:SS[.F0/F1]. Renderers that don't know a time send
+// "NOT_IMPLEMENTED", and that's an error.
+func ParseTime(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	ss := strings.Split(s, ":")
+	if len(ss) != 3 {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	h, err := strconv.ParseUint(strings.TrimPrefix(ss[0], "+"), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	m, err := strconv.ParseUint(ss[1], 10, 8)
+	if err != nil || m > 59 {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	sec, frac := ss[2], ""
+	if i := strings.IndexByte(sec, '.'); i >= 0 {
+		sec, frac = sec[:i], sec[i+1:]
+	}
+	secs, err := strconv.ParseUint(sec, 10, 8)
+	if err != nil || secs > 59 {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	ret := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(secs)*time.Second
+	if frac == "" {
+		return ret, nil
+	}
+	if i := strings.IndexByte(frac, '/'); i >= 0 {
+		n, err1 := strconv.ParseUint(frac[:i], 10, 32)
+		d, err2 := strconv.ParseUint(frac[i+1:], 10, 32)
+		if err1 != nil || err2 != nil || d == 0 || n >= d {
+			return 0, fmt.Errorf("bad time %q", s)
+		}
+		return ret + time.Duration(n)*time.Second/time.Duration(d), nil
+	}
+	f, err := strconv.ParseFloat("0."+frac, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	return ret + time.Duration(f*float64(time.Second)), nil
+}
@@ -0,0 +1,267 @@
+package dmc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anacrolix/dms/soap"
+)
+
+// The renderer is embedded in another device, as some TVs do, and its
+// control URLs are relative.
+const rendererDesc = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <specVersion><major>1</major><minor>0</minor></specVersion>
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:Basic:1</deviceType>
+    <friendlyName>TV</friendlyName>
+    <UDN>uuid:tv</UDN>
+    <deviceList>
+      <device>
+        <deviceType>urn:schemas-upnp-org:device:MediaRenderer:1</deviceType>
+        <friendlyName>Living Room</friendlyName>
+        <UDN>uuid:renderer</UDN>
+        <serviceList>
+          <service>
+            <serviceType>urn:schemas-upnp-org:service:RenderingControl:1</serviceType>
+            <serviceId>urn:upnp-org:serviceId:RenderingControl</serviceId>
+            <controlURL>rc/ctl</controlURL>
+          </service>
+          <service>
+            <serviceType>urn:schemas-upnp-org:service:AVTransport:1</serviceType>
+            <serviceId>urn:upnp-org:serviceId:AVTransport</serviceId>
+            <controlURL>/avt/ctl</controlURL>
+          </service>
+          <service>
+            <serviceType>urn:schemas-upnp-org:service:ConnectionManager:1</serviceType>
+            <serviceId>urn:upnp-org:serviceId:ConnectionManager</serviceId>
+            <controlURL>cm/ctl</controlURL>
+          </service>
+        </serviceList>
+      </device>
+    </deviceList>
+  </device>
+</root>`
+
+type call struct {
+	Action string
+	Args   []soap.Arg
+}
+
+// A renderer that records actions, and plays through the given states.
+type fakeRenderer struct {
+	mu     sync.Mutex
+	calls  []call
+	states []string
+}
+
+func (f *fakeRenderer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/dev/desc.xml" {
+		w.Write([]byte(rendererDesc))
+		return
+	}
+	body, err := soap.ReadEnvelope(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	a, err := soap.UnmarshalAction(body.Action)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	urn := a.XMLName.Space
+	if (r.URL.Path == "/avt/ctl") != strings.HasSuffix(urn, ":AVTransport:1") {
+		http.Error(w, "wrong control URL", http.StatusNotFound)
+		return
+	}
+	f.mu.Lock()
+	f.calls = append(f.calls, call{a.XMLName.Local, a.Args})
+	var out []soap.Arg
+	switch a.XMLName.Local {
+	case "GetTransportInfo":
+		state := f.states[0]
+		if len(f.states) > 1 {
+			f.states = f.states[1:]
+		}
+		out = []soap.Arg{
+			soap.NewArg("CurrentTransportState", state),
+			soap.NewArg("CurrentTransportStatus", "OK"),
+			soap.NewArg("CurrentSpeed", "1"),
+		}
+	case "GetPositionInfo":
+		out = []soap.Arg{
+			soap.NewArg("Track", "1"),
+			soap.NewArg("TrackDuration", "0:03:20"),
+			soap.NewArg("TrackMetaData", ""),
+			soap.NewArg("TrackURI", "http://dms/res"),
+			soap.NewArg("RelTime", "0:01:02.500"),
+			soap.NewArg("AbsTime", "NOT_IMPLEMENTED"),
+		}
+	case "GetVolume":
+		out = []soap.Arg{soap.NewArg("CurrentVolume", "42")}
+	case "GetProtocolInfo":
+		out = []soap.Arg{
+			soap.NewArg("Source", ""),
+			soap.NewArg("Sink", "http-get:*:video/mp4:*, http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_PAL,"),
+		}
+	}
+	f.mu.Unlock()
+	w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+	w.Write(soap.MarshalEnvelope(soap.MarshalAction(urn, a.XMLName.Local+"Response", out)))
+}
+
+func (f *fakeRenderer) actions() (ret []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, c := range f.calls {
+		ret = append(ret, c.Action)
+	}
+	return
+}
+
+func newFakeRenderer(t *testing.T, states ...string) (*fakeRenderer, *Renderer, func()) {
+	f := &fakeRenderer{states: append(states, Stopped)}
+	s := httptest.NewServer(f)
+	r, err := NewRenderer(context.Background(), s.URL+"/dev/desc.xml")
+	if err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+	return f, r, s.Close
+}
+
+func TestNewRenderer(t *testing.T) {
+	_, r, close := newFakeRenderer(t)
+	defer close()
+	if r.FriendlyName != "Living Room" || r.UDN != "uuid:renderer" {
+		t.Errorf("wrong device: %+v", r)
+	}
+	base := strings.TrimSuffix(r.Location, "/dev/desc.xml")
+	if r.avTransport.controlURL != base+"/avt/ctl" || r.renderingControl.controlURL != base+"/dev/rc/ctl" {
+		t.Errorf("wrong control URLs: %+v %+v", r.avTransport, r.renderingControl)
+	}
+	for _, name := range []string{"uuid:renderer", "renderer", "living room", r.Location} {
+		if !r.Match(name) {
+			t.Errorf("%q doesn't match", name)
+		}
+	}
+	if r.Match("TV") {
+		t.Error("matched the root device")
+	}
+}
+
+func TestActions(t *testing.T) {
+	f, r, close := newFakeRenderer(t)
+	defer close()
+	ctx := context.Background()
+	for _, err := range []error{
+		r.SetAVTransportURI(ctx, "http://dms/res?path=%2Fa.mp3", "<DIDL-Lite/>"),
+		r.Play(ctx),
+		r.Seek(ctx, 90*time.Minute+5*time.Second+time.Millisecond),
+		r.SetVolume(ctx, 20),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if v, err := r.Volume(ctx); err != nil || v != 42 {
+		t.Errorf("got volume %d, %v", v, err)
+	}
+	if sink, err := r.ProtocolInfo(ctx); err != nil || !reflect.DeepEqual(sink, []string{"http-get:*:video/mp4:*", "http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_PAL"}) {
+		t.Errorf("got sink %q, %v", sink, err)
+	}
+	pi, err := r.PositionInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pi != (PositionInfo{1, 200 * time.Second, "http://dms/res", "", 62500 * time.Millisecond}) {
+		t.Errorf("wrong position info: %+v", pi)
+	}
+	expected := []call{
+		{"SetAVTransportURI", []soap.Arg{
+			soap.NewArg("InstanceID", "0"),
+			soap.NewArg("CurrentURI", "http://dms/res?path=%2Fa.mp3"),
+			soap.NewArg("CurrentURIMetaData", "<DIDL-Lite/>"),
+		}},
+		{"Play", []soap.Arg{soap.NewArg("InstanceID", "0"), soap.NewArg("Speed", "1")}},
+		{"Seek", []soap.Arg{
+			soap.NewArg("InstanceID", "0"),
+			soap.NewArg("Unit", "REL_TIME"),
+			soap.NewArg("Target", "1:30:05"),
+		}},
+		{"SetVolume", []soap.Arg{
+			soap.NewArg("InstanceID", "0"),
+			soap.NewArg("Channel", "Master"),
+			soap.NewArg("DesiredVolume", "20"),
+		}},
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range f.calls {
+		for j := range f.calls[i].Args {
+			f.calls[i].Args[j].XMLName.Space = ""
+		}
+	}
+	if !reflect.DeepEqual(f.calls[:len(expected)], expected) {
+		t.Errorf("wrong calls:\n%+v\nexpected\n%+v", f.calls[:len(expected)], expected)
+	}
+}
+
+func TestWait(t *testing.T) {
+	f, r, close := newFakeRenderer(t, Stopped, Transitioning, Playing, PausedPlayback, Playing)
+	defer close()
+	var states []string
+	err := r.Wait(context.Background(), time.Millisecond, func(ti TransportInfo, pi PositionInfo) {
+		states = append(states, ti.State)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{Stopped, Transitioning, Playing, PausedPlayback, Playing, Stopped}
+	if !reflect.DeepEqual(states, expected) {
+		t.Errorf("got states %q", states)
+	}
+	if n := len(f.actions()); n != 2*len(expected) {
+		t.Errorf("made %d calls", n)
+	}
+}
+
+func TestWaitNeverStarts(t *testing.T) {
+	defer func(d time.Duration) { startTimeout = d }(startTimeout)
+	startTimeout = 10 * time.Millisecond
+	_, r, close := newFakeRenderer(t, Stopped)
+	defer close()
+	if err := r.Wait(context.Background(), time.Millisecond, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	for s, expected := range map[string]time.Duration{
+		"0:00:00":      0,
+		"1:02:03":      time.Hour + 2*time.Minute + 3*time.Second,
+		"100:00:01.25": 100*time.Hour + 1250*time.Millisecond,
+		"0:00:01.1/4":  1250 * time.Millisecond,
+		" +0:01:00 ":   time.Minute,
+	} {
+		d, err := ParseTime(s)
+		if err != nil || d != expected {
+			t.Errorf("%q: got %s, %v, expected %s", s, d, err, expected)
+		}
+	}
+	for _, s := range []string{"NOT_IMPLEMENTED", "", "1:60:00", "0:00:61", "0:00:01.4/4", "1:2"} {
+		if _, err := ParseTime(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+	if s := FormatTime(26*time.Hour + 3*time.Minute + 4500*time.Millisecond); s != "26:03:04" {
+		t.Errorf("formatted %q", s)
+	}
+}
@@ -0,0 +1,153 @@
+package dmc
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anacrolix/dms/soap"
+)
+
+var ErrNoEvents = errors.New("renderer has no AVTransport events")
+
+// SetNextAVTransportURI sets the media to play when the current media ends,
+// for gapless playback. Not every renderer has this optional action.
+func (r *Renderer) SetNextAVTransportURI(ctx context.Context, uri, metadata string) error {
+	_, err := r.call(ctx, r.avTransport, "SetNextAVTransportURI",
+		soap.NewArg("NextURI", uri),
+		soap.NewArg("NextURIMetaData", metadata))
+	return err
+}
+
+// Sends a GENA request to the AVTransport event URL, returning the response
+// SID and TIMEOUT. See UDA 1.1 section 4.1.
+func (r *Renderer) gena(ctx context.Context, method string, header http.Header) (sid string, timeout time.Duration, err error) {
+	if r.avTransport.eventSubURL == "" {
+		err = ErrNoEvents
+		return
+	}
+	req, err := http.NewRequest(method, r.avTransport.eventSubURL, nil)
+	if err != nil {
+		return
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("%s: unexpected status %q", method, resp.Status)
+		return
+	}
+	sid = resp.Header.Get("SID")
+	// "infinite" was deprecated by UDA 1.1, and is taken as the requested
+	// time, to be renewed anyway.
+	timeout = parseTimeout(resp.Header.Get("TIMEOUT"))
+	return
+}
+
+func formatTimeout(d time.Duration) string {
+	return fmt.Sprintf("Second-%d", int64(d/time.Second))
+}
+
+// Returns zero if the timeout isn't given in seconds.
+func parseTimeout(s string) time.Duration {
+	secs, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(s), "Second-"), 10, 32)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// Subscribe subscribes the callback URL to AVTransport events, returning the
+// subscription ID and how long it lasts before it must be renewed.
+func (r *Renderer) Subscribe(ctx context.Context, callback string, timeout time.Duration) (sid string, granted time.Duration, err error) {
+	sid, granted, err = r.gena(ctx, "SUBSCRIBE", http.Header{
+		"CALLBACK": {"<" + callback + ">"},
+		"NT":       {"upnp:event"},
+		"TIMEOUT":  {formatTimeout(timeout)},
+	})
+	if err == nil && sid == "" {
+		err = errors.New("SUBSCRIBE: no SID in response")
+	}
+	if granted == 0 {
+		granted = timeout
+	}
+	return
+}
+
+// Renew extends a subscription.
+func (r *Renderer) Renew(ctx context.Context, sid string, timeout time.Duration) (granted time.Duration, err error) {
+	_, granted, err = r.gena(ctx, "SUBSCRIBE", http.Header{
+		"SID":     {sid},
+		"TIMEOUT": {formatTimeout(timeout)},
+	})
+	if granted == 0 {
+		granted = timeout
+	}
+	return
+}
+
+func (r *Renderer) Unsubscribe(ctx context.Context, sid string) error {
+	_, _, err := r.gena(ctx, "UNSUBSCRIBE", http.Header{"SID": {sid}})
+	return err
+}
+
+// ParseEvent returns the state variables in the body of an event NOTIFY
+// request.
+func ParseEvent(body io.Reader) (map[string]string, error) {
+	var ps struct {
+		Properties []struct {
+			Vars []struct {
+				XMLName xml.Name
+				Value   string `xml:",chardata"`
+			} `xml:",any"`
+		} `xml:"property"`
+	}
+	if err := xml.NewDecoder(body).Decode(&ps); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]string)
+	for _, p := range ps.Properties {
+		for _, v := range p.Vars {
+			ret[v.XMLName.Local] = v.Value
+		}
+	}
+	return ret, nil
+}
+
+// ParseLastChange returns the state variables of instance 0 in a LastChange
+// value, such as TransportState and CurrentTrackURI.
+func ParseLastChange(s string) (map[string]string, error) {
+	var e struct {
+		Instances []struct {
+			ID   string `xml:"val,attr"`
+			Vars []struct {
+				XMLName xml.Name
+				Value   string `xml:"val,attr"`
+			} `xml:",any"`
+		} `xml:"InstanceID"`
+	}
+	if err := xml.Unmarshal([]byte(s), &e); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]string)
+	for _, i := range e.Instances {
+		if strings.TrimSpace(i.ID) != "0" {
+			continue
+		}
+		for _, v := range i.Vars {
+			ret[v.XMLName.Local] = v.Value
+		}
+	}
+	return ret, nil
+}
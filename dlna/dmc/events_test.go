@@ -0,0 +1,62 @@
+package dmc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseEvent(t *testing.T) {
+	vars, err := ParseEvent(strings.NewReader(`<?xml version="1.0"?>
+<e:propertyset xmlns:e="urn:schemas-upnp-org:event-1-0">
+  <e:property>
+    <LastChange>&lt;Event xmlns=&quot;urn:schemas-upnp-org:metadata-1-0/AVT/&quot;&gt;&lt;InstanceID val=&quot;0&quot;&gt;&lt;TransportState val=&quot;PAUSED_PLAYBACK&quot;/&gt;&lt;/InstanceID&gt;&lt;/Event&gt;</LastChange>
+  </e:property>
+</e:propertyset>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lc, err := ParseLastChange(vars["LastChange"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lc["TransportState"] != PausedPlayback {
+		t.Errorf("got %q", lc)
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	var got []http.Header
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header)
+		if r.Method == "SUBSCRIBE" {
+			w.Header().Set("SID", "uuid:sub")
+			w.Header().Set("TIMEOUT", "Second-120")
+		}
+	}))
+	defer s.Close()
+	r := &Renderer{avTransport: service{eventSubURL: s.URL}}
+	ctx := context.Background()
+	sid, granted, err := r.Subscribe(ctx, "http://dms/evt", time.Hour)
+	if err != nil || sid != "uuid:sub" || granted != 2*time.Minute {
+		t.Fatalf("got %q %s %v", sid, granted, err)
+	}
+	if _, err := r.Renew(ctx, sid, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Unsubscribe(ctx, sid); err != nil {
+		t.Fatal(err)
+	}
+	if h := got[0]; h.Get("CALLBACK") != "<http://dms/evt>" || h.Get("NT") != "upnp:event" || h.Get("TIMEOUT") != "Second-3600" {
+		t.Errorf("bad subscribe: %v", h)
+	}
+	if h := got[1]; h.Get("SID") != sid || h.Get("CALLBACK") != "" {
+		t.Errorf("bad renewal: %v", h)
+	}
+	if _, _, err := (&Renderer{}).Subscribe(ctx, "http://dms/evt", time.Hour); err != ErrNoEvents {
+		t.Errorf("got %v", err)
+	}
+}
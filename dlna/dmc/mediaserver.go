@@ -0,0 +1,131 @@
+package dmc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anacrolix/dms/soap"
+	"github.com/anacrolix/dms/ssdp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+const (
+	MediaServerType      = "urn:schemas-upnp-org:device:MediaServer:1"
+	ContentDirectoryType = "urn:schemas-upnp-org:service:ContentDirectory:1"
+)
+
+// Browse flags.
+const (
+	BrowseMetadata       = "BrowseMetadata"
+	BrowseDirectChildren = "BrowseDirectChildren"
+)
+
+// A MediaServer, and the control URL of its ContentDirectory.
+type MediaServer struct {
+	Device
+	// Used for actions, defaults to soap.DefaultClient.
+	Client *soap.Client
+
+	contentDirectory service
+}
+
+// BrowseResult is the result of a Browse or Search.
+type BrowseResult struct {
+	Result upnpav.DIDLLite
+	// The DIDL-Lite document as it was sent.
+	ResultXML      string
+	NumberReturned uint
+	TotalMatches   uint
+	UpdateID       uint32
+}
+
+// NewMediaServer fetches the device description at location, and returns
+// the MediaServer it describes.
+func NewMediaServer(ctx context.Context, location string) (*MediaServer, error) {
+	d, base, err := fetchDevice(ctx, location, "MediaServer")
+	if err != nil {
+		return nil, err
+	}
+	ms := &MediaServer{Device: Device{d.FriendlyName, d.UDN, location}}
+	for _, s := range d.ServiceList {
+		if typeName(s.ServiceType) != "ContentDirectory" {
+			continue
+		}
+		if ms.contentDirectory, err = newService(base, s); err != nil {
+			return nil, fmt.Errorf("%s: %s", location, err)
+		}
+	}
+	if ms.contentDirectory.controlURL == "" {
+		return nil, fmt.Errorf("%s: server has no ContentDirectory service", location)
+	}
+	return ms, nil
+}
+
+// DiscoverMediaServers searches for MediaServers with the given client,
+// which may be nil.
+func DiscoverMediaServers(ctx context.Context, c *ssdp.Client, mx time.Duration) ([]*MediaServer, error) {
+	return discover(ctx, c, MediaServerType, mx, NewMediaServer)
+}
+
+// FindMediaServer returns the server named, as by Match. A URL is taken as
+// the server's location, and isn't searched for. If name is empty, there
+// must be only one server.
+func FindMediaServer(ctx context.Context, c *ssdp.Client, mx time.Duration, name string) (*MediaServer, error) {
+	return find(ctx, c, MediaServerType, mx, name, "server", NewMediaServer)
+}
+
+// Browse returns an object's metadata, or its children, by flag. Count is
+// the most objects wanted, or zero for all of them. Servers may return fewer
+// than asked for, even all being wanted.
+func (ms *MediaServer) Browse(ctx context.Context, objectID, flag string, start, count uint) (BrowseResult, error) {
+	out, err := ms.contentDirectory.call(ctx, ms.Client, "Browse",
+		soap.NewArg("ObjectID", objectID),
+		soap.NewArg("BrowseFlag", flag),
+		soap.NewArg("Filter", "*"),
+		soap.NewArg("StartingIndex", strconv.FormatUint(uint64(start), 10)),
+		soap.NewArg("RequestedCount", strconv.FormatUint(uint64(count), 10)),
+		soap.NewArg("SortCriteria", ""))
+	if err != nil {
+		return BrowseResult{}, err
+	}
+	return parseBrowseResult("Browse", out)
+}
+
+// Search returns the objects in a container and its descendants that match
+// the criteria, as in ContentDirectory:1 section 2.5.5, such as
+// `upnp:class derivedfrom "object.item.audioItem"`. Not every server
+// supports it. Count is as for Browse.
+func (ms *MediaServer) Search(ctx context.Context, containerID, criteria string, start, count uint) (BrowseResult, error) {
+	out, err := ms.contentDirectory.call(ctx, ms.Client, "Search",
+		soap.NewArg("ContainerID", containerID),
+		soap.NewArg("SearchCriteria", criteria),
+		soap.NewArg("Filter", "*"),
+		soap.NewArg("StartingIndex", strconv.FormatUint(uint64(start), 10)),
+		soap.NewArg("RequestedCount", strconv.FormatUint(uint64(count), 10)),
+		soap.NewArg("SortCriteria", ""))
+	if err != nil {
+		return BrowseResult{}, err
+	}
+	return parseBrowseResult("Search", out)
+}
+
+// Parses the output arguments of Browse and Search, which are the same.
+func parseBrowseResult(action string, out map[string]string) (ret BrowseResult, err error) {
+	ret.ResultXML = out["Result"]
+	if ret.Result, err = upnpav.UnmarshalDIDLLite([]byte(out["Result"])); err != nil {
+		err = fmt.Errorf("bad %s result: %s", action, err)
+		return
+	}
+	// Servers are lax with these, the result matters more.
+	parse := func(s string) uint64 {
+		n, _ := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+		return n
+	}
+	ret.NumberReturned = uint(parse(out["NumberReturned"]))
+	ret.TotalMatches = uint(parse(out["TotalMatches"]))
+	ret.UpdateID = uint32(parse(out["UpdateID"]))
+	return
+}
@@ -0,0 +1,91 @@
+package dmc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/anacrolix/dms/soap"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+const mediaServerDesc = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <URLBase>http://base/</URLBase>
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:MediaServer:1</deviceType>
+    <friendlyName>NAS</friendlyName>
+    <UDN>uuid:nas</UDN>
+    <serviceList>
+      <service>
+        <serviceType>urn:schemas-upnp-org:service:ContentDirectory:1</serviceType>
+        <serviceId>urn:upnp-org:serviceId:ContentDirectory</serviceId>
+        <controlURL>/cds</controlURL>
+      </service>
+    </serviceList>
+  </device>
+</root>`
+
+func TestBrowse(t *testing.T) {
+	var got map[string]string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cds" {
+			// Control URLs are relative to the URLBase.
+			w.Write([]byte(strings.Replace(mediaServerDesc, "http://base/", "http://"+r.Host+"/", 1)))
+			return
+		}
+		body, _ := soap.ReadEnvelope(r.Body)
+		a, _ := soap.UnmarshalAction(body.Action)
+		got = map[string]string{"action": a.XMLName.Local}
+		for _, arg := range a.Args {
+			got[arg.XMLName.Local] = arg.Value
+		}
+		result, _ := upnpav.MarshalDIDLLite(upnpav.Item{
+			Object: upnpav.Object{ID: "1", ParentID: "0", Title: "a.mp3", Class: "object.item.audioItem"},
+			Res:    []upnpav.Resource{{URL: "http://nas/a.mp3", ProtocolInfo: "http-get:*:audio/mpeg:*"}},
+		})
+		w.Write(soap.MarshalEnvelope(soap.MarshalAction(a.XMLName.Space, a.XMLName.Local+"Response", []soap.Arg{
+			soap.NewArg("Result", string(result)),
+			soap.NewArg("NumberReturned", "1"),
+			soap.NewArg("TotalMatches", "3"),
+			soap.NewArg("UpdateID", "7"),
+		})))
+	}))
+	defer s.Close()
+	ctx := context.Background()
+	ms, err := NewMediaServer(ctx, s.URL+"/desc.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms.FriendlyName != "NAS" || ms.UDN != "uuid:nas" || ms.contentDirectory.controlURL != s.URL+"/cds" {
+		t.Errorf("wrong server: %+v", ms)
+	}
+	res, err := ms.Browse(ctx, "0", BrowseDirectChildren, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got["action"] != "Browse" || got["ObjectID"] != "0" || got["BrowseFlag"] != BrowseDirectChildren || got["StartingIndex"] != "2" || got["RequestedCount"] != "1" {
+		t.Errorf("wrong arguments: %q", got)
+	}
+	if res.NumberReturned != 1 || res.TotalMatches != 3 || res.UpdateID != 7 {
+		t.Errorf("wrong counts: %+v", res)
+	}
+	if items := res.Result.Items(); len(items) != 1 || items[0].Title != "a.mp3" || items[0].Res[0].URL != "http://nas/a.mp3" {
+		t.Errorf("wrong result: %+v", res.Result)
+	}
+	if !ms.Match("nas") || !ms.Match("uuid:nas") {
+		t.Error("doesn't match its name and UDN")
+	}
+	res, err = ms.Search(ctx, "music", `upnp:class derivedfrom "object.item"`, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got["action"] != "Search" || got["ContainerID"] != "music" || got["SearchCriteria"] != `upnp:class derivedfrom "object.item"` {
+		t.Errorf("wrong arguments: %q", got)
+	}
+	if len(res.Result.Items()) != 1 || !strings.Contains(res.ResultXML, "a.mp3") {
+		t.Errorf("wrong result: %+v", res)
+	}
+}
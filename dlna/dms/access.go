@@ -0,0 +1,226 @@
+package dms
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ClientMatch selects clients for AccessRules and Profiles. A client
+// matches if it matches one entry of each kind of criteria given, so one
+// without criteria matches every client.
+type ClientMatch struct {
+	// IP addresses or CIDR networks, such as "192.168.1.20" or
+	// "192.168.1.0/24".
+	Addrs []string `json:"addrs,omitempty"`
+	// MAC addresses, looked up in the ARP table. Only Linux has one to look
+	// in, so elsewhere criteria with MACs don't match.
+	MACs []string `json:"macs,omitempty"`
+	// Substrings of the User-Agent, ignoring case.
+	UserAgents []string `json:"userAgents,omitempty"`
+	// Substrings of the X-AV-Client-Info header, ignoring case.
+	ClientInfos []string `json:"clientInfos,omitempty"`
+	// Substrings of the FriendlyName.DLNA.ORG header, ignoring case.
+	FriendlyNames []string `json:"friendlyNames,omitempty"`
+}
+
+// The client of a request, as ClientMatch sees it.
+type client struct {
+	ip     net.IP
+	header http.Header
+}
+
+func newClient(r *http.Request) client {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	// Drop any IPv6 zone.
+	return client{net.ParseIP(strings.SplitN(host, "%", 2)[0]), r.Header}
+}
+
+// Checks the criteria parse.
+func (m *ClientMatch) check() error {
+	for _, a := range m.Addrs {
+		if _, _, err := net.ParseCIDR(a); err != nil && net.ParseIP(a) == nil {
+			return fmt.Errorf("bad address %q", a)
+		}
+	}
+	for _, mac := range m.MACs {
+		if _, err := net.ParseMAC(mac); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Whether the client matches the criteria.
+func (m *ClientMatch) matches(c client) bool {
+	if len(m.Addrs) != 0 && !m.matchesAddr(c.ip) {
+		return false
+	}
+	for _, h := range []struct {
+		subs  []string
+		value string
+	}{
+		{m.UserAgents, c.header.Get("User-Agent")},
+		{m.ClientInfos, c.header.Get("X-AV-Client-Info")},
+		{m.FriendlyNames, c.header.Get("FriendlyName.DLNA.ORG")},
+	} {
+		if len(h.subs) != 0 && !containsAnyFold(h.value, h.subs) {
+			return false
+		}
+	}
+	if len(m.MACs) != 0 && !m.matchesMAC(c.ip) {
+		return false
+	}
+	return true
+}
+
+func (m *ClientMatch) matchesAddr(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, a := range m.Addrs {
+		if _, n, err := net.ParseCIDR(a); err == nil {
+			if n.Contains(ip) {
+				return true
+			}
+		} else if net.ParseIP(a).Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether s contains any of subs, ignoring case.
+func containsAnyFold(s string, subs []string) bool {
+	s = strings.ToLower(s)
+	for _, sub := range subs {
+		if strings.Contains(s, strings.ToLower(sub)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *ClientMatch) matchesMAC(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	mac, err := lookupMAC(ip)
+	if err != nil {
+		log.Printf("error looking up MAC address of %s: %s", ip, err)
+		return false
+	}
+	for _, s := range m.MACs {
+		if hw, err := net.ParseMAC(s); err == nil && bytes.Equal(hw, mac) {
+			return true
+		}
+	}
+	return false
+}
+
+// An AccessRule restricts what's served to the clients it matches.
+type AccessRule struct {
+	ClientMatch
+	// Serve the clients nothing.
+	Deny bool `json:"deny,omitempty"`
+	// Object paths the clients can see, with everything below them, such as
+	// "/Kids" or "/Movies/Cartoons". The containers leading to them can be
+	// browsed too, but show only the way to them. Everything can be seen if
+	// it's empty. Aggregated servers are hidden when there are paths.
+	Paths []string `json:"paths,omitempty"`
+	// Media types hidden from the clients, such as "video".
+	HideMediaTypes []string `json:"hideMediaTypes,omitempty"`
+}
+
+// Checks the rules' criteria parse, and cleans their paths.
+func checkAccessRules(rules []AccessRule) error {
+	for i := range rules {
+		r := &rules[i]
+		if err := r.check(); err != nil {
+			return fmt.Errorf("%w: access rule %d: %s", errBadSettings, i, err)
+		}
+		paths := make([]string, 0, len(r.Paths))
+		for _, p := range r.Paths {
+			paths = append(paths, path.Clean("/"+p))
+		}
+		r.Paths = paths
+	}
+	return nil
+}
+
+// Returns the first of the AccessRules that matches the request's client, or
+// nil if none do.
+func (srv *Server) accessRule(r *http.Request) *AccessRule {
+	rules := srv.Settings().AccessRules
+	if len(rules) == 0 {
+		return nil
+	}
+	c := newClient(r)
+	for i := range rules {
+		if rules[i].matches(c) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// Whether the rule lets its clients see the object. Everything is allowed
+// without a rule.
+func (rule *AccessRule) allows(o object, isDir bool) bool {
+	if rule == nil {
+		return true
+	}
+	if rule.Deny {
+		return false
+	}
+	if len(rule.Paths) != 0 {
+		visible := false
+		for _, p := range rule.Paths {
+			if isPathBelow(o.Path, p) || isDir && isPathBelow(p, o.Path) {
+				visible = true
+				break
+			}
+		}
+		if !visible {
+			return false
+		}
+	}
+	if isDir || len(rule.HideMediaTypes) == 0 {
+		return true
+	}
+	mt, err := MimeTypeByPath(o.FilePath())
+	if err != nil {
+		return false
+	}
+	return !rule.hidesMediaType(mt.Type())
+}
+
+// Whether the rule hides the media type, such as "video".
+func (rule *AccessRule) hidesMediaType(t string) bool {
+	if rule == nil {
+		return false
+	}
+	for _, h := range rule.HideMediaTypes {
+		if h == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the rule lets its clients see the aggregated servers.
+func (rule *AccessRule) allowsAggregated() bool {
+	return rule == nil || !rule.Deny && len(rule.Paths) == 0
+}
+
+// Whether the object path p is dir or below it.
+func isPathBelow(p, dir string) bool {
+	return p == dir || dir == "/" || strings.HasPrefix(p, dir+"/")
+}
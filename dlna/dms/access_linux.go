@@ -0,0 +1,39 @@
+//+build linux
+
+package dms
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// Returns the MAC address of a neighbour from the kernel's ARP table.
+func lookupMAC(ip net.IP) (net.HardwareAddr, error) {
+	f, err := os.Open("/proc/net/arp")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return nil, fmt.Errorf("%s isn't IPv4", ip)
+	}
+	s := bufio.NewScanner(f)
+	// Skip the header.
+	s.Scan()
+	for s.Scan() {
+		// IP address, HW type, Flags, HW address, Mask, Device.
+		fields := strings.Fields(s.Text())
+		if len(fields) < 4 || fields[0] != ip4.String() || fields[2] == "0x0" {
+			continue
+		}
+		return net.ParseMAC(fields[3])
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%s isn't in the ARP table", ip)
+}
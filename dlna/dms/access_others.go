@@ -0,0 +1,12 @@
+//+build !linux
+
+package dms
+
+import (
+	"errors"
+	"net"
+)
+
+func lookupMAC(ip net.IP) (net.HardwareAddr, error) {
+	return nil, errors.New("not supported on this platform")
+}
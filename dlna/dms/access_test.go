@@ -0,0 +1,150 @@
+package dms
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClientMatch(t *testing.T) {
+	m := ClientMatch{Addrs: []string{"192.168.1.0/24", "10.0.0.1"}, UserAgents: []string{"kidstv"}}
+	for _, c := range []struct {
+		ip, userAgent string
+		want          bool
+	}{
+		{"192.168.1.7", "Mozilla KidsTV/2", true},
+		{"10.0.0.1", "KIDSTV", true},
+		{"10.0.0.2", "KidsTV", false},
+		{"192.168.1.7", "VLC", false},
+	} {
+		cl := client{net.ParseIP(c.ip), http.Header{"User-Agent": {c.userAgent}}}
+		if got := m.matches(cl); got != c.want {
+			t.Errorf("%s %q: got %v", c.ip, c.userAgent, got)
+		}
+	}
+	cl := client{nil, http.Header{"X-Av-Client-Info": {`av=5.0; cn="Sony Corporation"; mn="BRAVIA KDL-40EX720"`}}}
+	if !(&ClientMatch{}).matches(cl) || !(&ClientMatch{ClientInfos: []string{"bravia"}}).matches(cl) {
+		t.Error("client info didn't match")
+	}
+	if (&ClientMatch{MACs: []string{"00:11:22:33:44:55"}}).matches(cl) {
+		t.Error("matched MAC without an address")
+	}
+}
+
+func newAccessTestServer(t *testing.T) *Server {
+	dir := t.TempDir()
+	writeTestTree(t, dir, map[string]string{"Kids/a.mp4": "data", "Kids/b.mp3": "data", "Movies/c.mp4": "data"})
+	srv := &Server{
+		RootObjectPath: dir,
+		NoProbe:        true,
+		NoTranscode:    true,
+		AccessRules: []AccessRule{
+			{ClientMatch: ClientMatch{UserAgents: []string{"KidsTV"}}, Paths: []string{"Kids/"}, HideMediaTypes: []string{"audio"}},
+			{ClientMatch: ClientMatch{Addrs: []string{"10.0.0.0/8"}}, Deny: true},
+		},
+	}
+	if err := checkAccessRules(srv.AccessRules); err != nil {
+		t.Fatal(err)
+	}
+	return srv
+}
+
+func TestAccessRules(t *testing.T) {
+	srv := newAccessTestServer(t)
+	mux := http.NewServeMux()
+	srv.initMux(mux)
+	get := func(target, userAgent, remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "http://dms"+target, nil)
+		r.Header.Set("User-Agent", userAgent)
+		if remoteAddr != "" {
+			r.RemoteAddr = remoteAddr
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+	ids := func(w *httptest.ResponseRecorder) string {
+		var l objectsJSON
+		if err := json.NewDecoder(w.Body).Decode(&l); err != nil {
+			t.Fatal(err)
+		}
+		return strings.Join(objectIDs(l), " ")
+	}
+	for _, c := range []struct {
+		target, userAgent, remoteAddr string
+		code                          int
+		ids                           string
+	}{
+		// Only the way to the allowed path, and not the audio below it.
+		{objectsPath + "/0/children", "KidsTV", "", http.StatusOK, "%2FKids"},
+		{objectsPath + "/%252FKids/children", "KidsTV", "", http.StatusOK, "%2FKids%2Fa.mp4"},
+		{objectsPath + "/%252FMovies/children", "KidsTV", "", http.StatusNotFound, ""},
+		{objectsPath + "/%252FMovies%252Fc.mp4", "KidsTV", "", http.StatusNotFound, ""},
+		{searchPath + "?q=.mp", "KidsTV", "", http.StatusOK, "%2FKids%2Fa.mp4"},
+		{searchPath + "?q=.mp&container=%252FMovies", "KidsTV", "", http.StatusNotFound, ""},
+		{"/res?path=%2FKids%2Fa.mp4", "KidsTV", "", http.StatusOK, ""},
+		{"/res?path=%2FKids%2Fb.mp3", "KidsTV", "", http.StatusNotFound, ""},
+		{"/res?path=%2FMovies%2Fc.mp4", "KidsTV", "", http.StatusNotFound, ""},
+		{"/icon?path=%2FMovies%2Fc.mp4", "KidsTV", "", http.StatusNotFound, ""},
+		// Denied clients see nothing at all.
+		{objectsPath + "/0/children", "VLC", "10.1.2.3:5000", http.StatusNotFound, ""},
+		{"/res?path=%2FKids%2Fa.mp4", "VLC", "10.1.2.3:5000", http.StatusNotFound, ""},
+		// Other clients see everything.
+		{searchPath + "?q=.mp", "VLC", "", http.StatusOK, "%2FKids%2Fa.mp4 %2FKids%2Fb.mp3 %2FMovies%2Fc.mp4"},
+		{"/res?path=%2FMovies%2Fc.mp4", "VLC", "", http.StatusOK, ""},
+	} {
+		w := get(c.target, c.userAgent, c.remoteAddr)
+		if w.Code != c.code {
+			t.Errorf("%s for %s: expected %d but got %d", c.target, c.userAgent, c.code, w.Code)
+			continue
+		}
+		if c.ids != "" {
+			if got := ids(w); got != c.ids {
+				t.Errorf("%s for %s: expected %q but got %q", c.target, c.userAgent, c.ids, got)
+			}
+		}
+	}
+}
+
+func TestApplyAccessRules(t *testing.T) {
+	srv := newAdminTestServer(t)
+	s := srv.Settings()
+	s.AccessRules = []AccessRule{{ClientMatch: ClientMatch{Addrs: []string{"192.168.1.0/33"}}, Deny: true}}
+	if err := srv.Apply(s); !errors.Is(err, errBadSettings) {
+		t.Fatalf("bad address applied: %v", err)
+	}
+	s.AccessRules = []AccessRule{{ClientMatch: ClientMatch{MACs: []string{"00:11:22:33:44:55"}}, Paths: []string{"kids/../Kids"}}}
+	if err := srv.Apply(s); err != nil {
+		t.Fatal(err)
+	}
+	if rules := srv.Settings().AccessRules; len(rules) != 1 || rules[0].Paths[0] != "/Kids" || srv.updateIDString() != "1" {
+		t.Fatalf("wrong rules applied: %+v", rules)
+	}
+}
+
+func TestAccessRulesCast(t *testing.T) {
+	srv := newAccessTestServer(t)
+	kids := &srv.AccessRules[0]
+	if _, err := srv.castItem("/Kids/a.mp4", "dms", nil, kids); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"/Kids/b.mp3", "/Movies/c.mp4"} {
+		if _, err := srv.castItem(p, "dms", nil, kids); !errors.Is(err, errNoSuchObject) {
+			t.Errorf("%s: got %v", p, err)
+		}
+	}
+	items, err := srv.containerItems("%2FKids", "dms", nil, kids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].ID != "%2FKids%2Fa.mp4" {
+		t.Fatalf("wrong items: %+v", items)
+	}
+	if _, err := srv.containerItems("%2FMovies", "dms", nil, kids); !errors.Is(err, errNoSuchObject) {
+		t.Errorf("got %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+package dms
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync/atomic"
+)
+
+const (
+	adminPath    = "/admin"
+	settingsPath = "/api/v1/settings"
+)
+
+var errBadSettings = errors.New("bad settings")
+
+// Settings are the parts of the Server's configuration that can be changed
+// while it's serving.
+type Settings struct {
+	FriendlyName     string       `json:"friendlyName"`
+	RootObjectPath   string       `json:"path"`
+	NoTranscode      bool         `json:"noTranscode"`
+	IgnoreHidden     bool         `json:"ignoreHidden"`
+	IgnoreUnreadable bool         `json:"ignoreUnreadable"`
+	Roots            []Root       `json:"roots,omitempty"`
+	AccessRules      []AccessRule `json:"accessRules,omitempty"`
+}
+
+// Settings returns the current settings.
+func (srv *Server) Settings() Settings {
+	srv.settingsMu.RLock()
+	defer srv.settingsMu.RUnlock()
+	return Settings{
+		FriendlyName:     srv.FriendlyName,
+		RootObjectPath:   srv.RootObjectPath,
+		NoTranscode:      srv.NoTranscode,
+		IgnoreHidden:     srv.IgnoreHidden,
+		IgnoreUnreadable: srv.IgnoreUnreadable,
+		Roots:            srv.Roots,
+		AccessRules:      srv.AccessRules,
+	}
+}
+
+// Apply changes the settings of a serving server all at once. Requests see
+// either the old settings or the new. Changes to the content bump the
+// SystemUpdateID, and a new FriendlyName is announced over SSDP with a new
+// CONFIGID. SaveSettings is called first, if it's set.
+func (srv *Server) Apply(s Settings) (err error) {
+	if s.FriendlyName == "" {
+		s.FriendlyName = getDefaultFriendlyName()
+	}
+	// The roots and rules are shared with readers once they're applied.
+	s.Roots = append([]Root(nil), s.Roots...)
+	s.AccessRules = append([]AccessRule(nil), s.AccessRules...)
+	err = checkContent(s.Roots, &s.RootObjectPath)
+	if err == nil {
+		err = checkAccessRules(s.AccessRules)
+	}
+	if err != nil {
+		return
+	}
+	srv.applyMu.Lock()
+	defer srv.applyMu.Unlock()
+	old := srv.Settings()
+	if reflect.DeepEqual(s, old) {
+		return
+	}
+	if srv.SaveSettings != nil {
+		if err = srv.SaveSettings(s); err != nil {
+			return fmt.Errorf("saving settings: %w", err)
+		}
+	}
+	srv.settingsMu.Lock()
+	srv.FriendlyName = s.FriendlyName
+	srv.RootObjectPath = s.RootObjectPath
+	srv.NoTranscode = s.NoTranscode
+	srv.IgnoreHidden = s.IgnoreHidden
+	srv.IgnoreUnreadable = s.IgnoreUnreadable
+	srv.Roots = s.Roots
+	srv.AccessRules = s.AccessRules
+	var configChanged bool
+	if s.FriendlyName != old.FriendlyName {
+		configChanged, err = srv.updateRootDesc()
+	}
+	srv.settingsMu.Unlock()
+	if err != nil {
+		return
+	}
+	old.FriendlyName = s.FriendlyName
+	if !reflect.DeepEqual(s, old) {
+		atomic.AddUint32(&srv.systemUpdateID, 1)
+	}
+	if !reflect.DeepEqual(s.roots(), old.roots()) && srv.scanner != nil {
+		srv.scanner.triggerRescan()
+	}
+	if configChanged {
+		select {
+		case srv.reconfigureSSDP <- struct{}{}:
+		default:
+		}
+	}
+	log.Printf("applied settings: %+v", s)
+	return
+}
+
+// Wraps an admin handler with HTTP basic authentication against the
+// AdminPassword. Browsers send the credentials with requests from other
+// sites, so changes from other origins are refused.
+func (srv *Server) adminHandler(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, password, ok := r.BasicAuth()
+		if !ok || subtle.ConstantTimeCompare([]byte(password), []byte(srv.AdminPassword)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="dms"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.Method != "GET" && r.Method != "HEAD" {
+			if origin := r.Header.Get("Origin"); origin != "" {
+				if u, err := url.Parse(origin); err != nil || u.Host != r.Host {
+					http.Error(w, "cross-origin request refused", http.StatusForbidden)
+					return
+				}
+			}
+		}
+		h(w, r)
+	}
+}
+
+// Applies settings, responding with the status for the error.
+func (srv *Server) applyRequest(w http.ResponseWriter, s Settings) bool {
+	err := srv.Apply(s)
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, errBadSettings):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+	return false
+}
+
+// Handles GET <settingsPath> for the settings, and PUT to change them.
+// Settings left out of a PUT are unchanged. Responds with the settings.
+func (srv *Server) serveSettings(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+	case "PUT":
+		s := srv.Settings()
+		if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !srv.applyRequest(w, s) {
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, srv.Settings())
+}
+
+// Shows the settings form, and applies it when it's posted.
+func (srv *Server) serveAdmin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+	case "POST":
+		// The form doesn't have the Roots.
+		s := srv.Settings()
+		s.FriendlyName = r.PostFormValue("friendlyName")
+		s.RootObjectPath = r.PostFormValue("path")
+		s.NoTranscode = r.PostFormValue("noTranscode") != ""
+		s.IgnoreHidden = r.PostFormValue("ignoreHidden") != ""
+		s.IgnoreUnreadable = r.PostFormValue("ignoreUnreadable") != ""
+		if !srv.applyRequest(w, s) {
+			return
+		}
+		http.Redirect(w, r, srv.pathPrefix+adminPath, http.StatusSeeOther)
+		return
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	srv.writeSettingsForm(w, false)
+}
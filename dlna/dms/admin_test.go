@@ -0,0 +1,98 @@
+package dms
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newAdminTestServer(t *testing.T) *Server {
+	srv := &Server{
+		FriendlyName:    "a",
+		RootObjectPath:  t.TempDir(),
+		AdminPassword:   "secret",
+		reconfigureSSDP: make(chan struct{}, 1),
+	}
+	if _, err := srv.updateRootDesc(); err != nil {
+		t.Fatal(err)
+	}
+	return srv
+}
+
+func TestApply(t *testing.T) {
+	srv := newAdminTestServer(t)
+	var saved []Settings
+	srv.SaveSettings = func(s Settings) error {
+		saved = append(saved, s)
+		return nil
+	}
+	s := srv.Settings()
+	configID := srv.configID
+	s.IgnoreHidden = true
+	if err := srv.Apply(s); err != nil {
+		t.Fatal(err)
+	}
+	if srv.updateIDString() != "1" || !srv.Settings().IgnoreHidden || len(saved) != 1 {
+		t.Fatalf("content change not applied: %q %+v %v", srv.updateIDString(), srv.Settings(), saved)
+	}
+	if srv.configID != configID || len(srv.reconfigureSSDP) != 0 {
+		t.Fatal("description changed")
+	}
+	if err := srv.Apply(s); err != nil || len(saved) != 1 {
+		t.Fatalf("unchanged settings applied: %v %v", err, saved)
+	}
+	s.FriendlyName = "b"
+	if err := srv.Apply(s); err != nil {
+		t.Fatal(err)
+	}
+	if srv.updateIDString() != "1" {
+		t.Error("content changed with the name")
+	}
+	if srv.configID == configID || len(srv.reconfigureSSDP) != 1 || !bytes.Contains(srv.rootDescXML, []byte("<friendlyName>b<")) {
+		t.Errorf("description not updated: %d %s", srv.configID, srv.rootDescXML)
+	}
+	s.RootObjectPath += "/missing"
+	if err := srv.Apply(s); !errors.Is(err, errBadSettings) || len(saved) != 2 {
+		t.Fatalf("bad path applied: %v", err)
+	}
+	srv.SaveSettings = func(Settings) error { return errors.New("read-only") }
+	s = srv.Settings()
+	s.NoTranscode = true
+	if err := srv.Apply(s); err == nil || srv.Settings().NoTranscode {
+		t.Fatalf("unsaved settings applied: %v", err)
+	}
+}
+
+func TestSettingsAPI(t *testing.T) {
+	srv := newAdminTestServer(t)
+	h := srv.adminHandler(srv.serveSettings)
+	put := func(body, password, origin string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("PUT", "http://dms"+settingsPath, strings.NewReader(body))
+		r.SetBasicAuth("admin", password)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		w := httptest.NewRecorder()
+		h(w, r)
+		return w
+	}
+	if w := put(`{"noTranscode":true}`, "wrong", ""); w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") == "" {
+		t.Fatal(w.Code)
+	}
+	if w := put(`{"noTranscode":true}`, "secret", "http://evil"); w.Code != http.StatusForbidden {
+		t.Fatal(w.Code)
+	}
+	if w := put(`{"path":"/nonexistent/dms"}`, "secret", ""); w.Code != http.StatusBadRequest {
+		t.Fatal(w.Code)
+	}
+	w := put(`{"noTranscode":true}`, "secret", "http://dms")
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"noTranscode":true`) {
+		t.Fatalf("%d %s", w.Code, w.Body)
+	}
+	if s := srv.Settings(); !s.NoTranscode || s.FriendlyName != "a" {
+		t.Fatalf("wrong settings: %+v", s)
+	}
+}
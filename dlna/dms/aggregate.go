@@ -0,0 +1,339 @@
+package dms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/soap"
+	"github.com/anacrolix/dms/ssdp"
+	"github.com/anacrolix/dms/upnp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+const (
+	// Resources of aggregated servers are proxied here, so that clients
+	// that can't reach those servers can still play them.
+	proxyPath = "/proxy"
+	// Time between searches for servers to aggregate. Servers announce
+	// themselves in between.
+	aggregateSearchInterval = time.Minute
+	// Time allowed for calls to aggregated servers.
+	aggregateTimeout = 10 * time.Second
+	// Prefixes the ObjectIDs of aggregated servers' objects. Local ObjectIDs
+	// are escaped absolute paths, so they can't have it.
+	remoteIDPrefix = "remote:"
+)
+
+// A MediaServer whose content is re-exported.
+type remoteServer struct {
+	*dmc.MediaServer
+	// The USN of the advertisement it was found by.
+	usn string
+}
+
+// Finds other MediaServers on the network, and re-exports their content,
+// each server as a container in the root.
+type aggregator struct {
+	srv     *Server
+	reg     *ssdp.Registry
+	mu      sync.Mutex
+	servers map[string]*remoteServer // By UDN.
+	// Signs proxy URLs, so that only those handed out are proxied.
+	key     []byte
+	stopped chan struct{}
+}
+
+func newAggregator(srv *Server) *aggregator {
+	a := &aggregator{
+		srv:     srv,
+		reg:     ssdp.NewRegistry(),
+		servers: make(map[string]*remoteServer),
+		key:     make([]byte, 32),
+		stopped: make(chan struct{}),
+	}
+	if _, err := rand.Read(a.key); err != nil {
+		panic(err)
+	}
+	a.reg.OnRemove = a.remove
+	return a
+}
+
+// Watches for servers until the Server is closed.
+func (a *aggregator) run() {
+	defer close(a.stopped)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-a.srv.closed
+		cancel()
+	}()
+	a.reg.OnAdd = func(e ssdp.RegistryEntry) {
+		go a.add(ctx, e)
+	}
+	err := a.reg.Watch(ctx, a.srv.ssdpClient(), dmc.MediaServerType, aggregateSearchInterval)
+	if err != nil && ctx.Err() == nil {
+		log.Printf("error watching for servers to aggregate: %s", err)
+	}
+}
+
+func (a *aggregator) add(ctx context.Context, e ssdp.RegistryEntry) {
+	ctx, cancel := context.WithTimeout(ctx, aggregateTimeout)
+	defer cancel()
+	ms, err := dmc.NewMediaServer(ctx, e.Location)
+	if err != nil {
+		log.Printf("error getting server to aggregate: %s", err)
+		return
+	}
+	if a.srv.isOwnDevice(ms.UDN) {
+		return
+	}
+	// It may have gone while its description was fetched.
+	found := false
+	for _, le := range a.reg.Entries(dmc.MediaServerType) {
+		found = found || le.USN == e.USN && le.Location == e.Location
+	}
+	if !found {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// Servers are found at each of their addresses. The first that worked
+	// is kept, so the URLs already handed out stay valid. It's dropped if
+	// it stops working.
+	if _, ok := a.servers[ms.UDN]; ok {
+		return
+	}
+	a.servers[ms.UDN] = &remoteServer{ms, e.USN}
+	log.Printf("aggregating %q at %s", ms.FriendlyName, ms.Location)
+}
+
+func (a *aggregator) remove(e ssdp.RegistryEntry) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for udn, rs := range a.servers {
+		if rs.usn == e.USN {
+			delete(a.servers, udn)
+			log.Printf("no longer aggregating %q", rs.FriendlyName)
+		}
+	}
+}
+
+// Forgets a server that stopped responding without saying goodbye. It's
+// added again if it answers a later search.
+func (a *aggregator) drop(rs *remoteServer) {
+	a.reg.Handle(ssdp.Advertisement{
+		Type: dmc.MediaServerType,
+		USN:  rs.usn,
+		NTS:  "ssdp:byebye",
+	})
+}
+
+func (a *aggregator) server(udn string) *remoteServer {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.servers[udn]
+}
+
+// Returns a container for each aggregated server, ordered by name.
+func (a *aggregator) containers() (ret []interface{}) {
+	a.mu.Lock()
+	rss := make([]*remoteServer, 0, len(a.servers))
+	for _, rs := range a.servers {
+		rss = append(rss, rs)
+	}
+	a.mu.Unlock()
+	sort.Slice(rss, func(i, j int) bool {
+		if rss[i].FriendlyName != rss[j].FriendlyName {
+			return rss[i].FriendlyName < rss[j].FriendlyName
+		}
+		return rss[i].UDN < rss[j].UDN
+	})
+	for _, rs := range rss {
+		ret = append(ret, upnpav.Container{Object: upnpav.Object{
+			ID:         remoteObjectID(rs.UDN, "0"),
+			ParentID:   "0",
+			Restricted: 1,
+			Class:      "object.container.storageFolder",
+			Title:      rs.FriendlyName,
+		}})
+	}
+	return
+}
+
+// Returns the local ObjectID for an object of an aggregated server.
+func remoteObjectID(udn, id string) string {
+	return remoteIDPrefix + url.QueryEscape(udn) + ":" + id
+}
+
+func parseRemoteObjectID(id string) (udn, remoteID string, ok bool) {
+	if !strings.HasPrefix(id, remoteIDPrefix) {
+		return
+	}
+	ss := strings.SplitN(id[len(remoteIDPrefix):], ":", 2)
+	if len(ss) != 2 {
+		return
+	}
+	udn, err := url.QueryUnescape(ss[0])
+	if err != nil {
+		return
+	}
+	return udn, ss[1], true
+}
+
+// Returns the signature that proves the proxy URL for the server's resource
+// at u was handed out by this server.
+func (a *aggregator) sign(udn, u string) []byte {
+	h := hmac.New(sha256.New, a.key)
+	h.Write([]byte(udn))
+	h.Write([]byte{0})
+	h.Write([]byte(u))
+	return h.Sum(nil)
+}
+
+// Returns the URL that proxies the server's resource at u, for clients
+// that reach this server at host.
+func (a *aggregator) proxyURL(host, udn, u string) string {
+	if u == "" {
+		return ""
+	}
+	return (&url.URL{
+		Scheme: "http",
+		Host:   host,
+		Path:   proxyPath,
+		RawQuery: url.Values{
+			"server": {udn},
+			"url":    {u},
+			"sig":    {hex.EncodeToString(a.sign(udn, u))},
+		}.Encode(),
+	}).String()
+}
+
+// Rewrites an aggregated server's object so that it's part of this server's
+// tree, and its resources are proxied.
+func (a *aggregator) rewriteRemoteObject(rs *remoteServer, o *upnpav.Object, host string) {
+	switch {
+	case o.ID == "0":
+		o.ParentID = "0"
+		o.Title = rs.FriendlyName
+	case o.ParentID == "-1" || o.ParentID == "":
+		o.ParentID = "0"
+	default:
+		o.ParentID = remoteObjectID(rs.UDN, o.ParentID)
+	}
+	o.ID = remoteObjectID(rs.UDN, o.ID)
+	o.Restricted = 1
+	o.Icon = a.proxyURL(host, rs.UDN, o.Icon)
+	for i := range o.AlbumArtURI {
+		o.AlbumArtURI[i].URI = a.proxyURL(host, rs.UDN, o.AlbumArtURI[i].URI)
+	}
+}
+
+// Handles a Browse of an aggregated server's object by forwarding it.
+func (a *aggregator) browseObjects(r *http.Request, udn, id, flag string, start, count uint) (objs []interface{}, total int, updateID string, err error) {
+	rs := a.server(udn)
+	if rs == nil {
+		err = upnp.Errorf(upnpav.NoSuchObjectErrorCode, "server %s is gone", udn)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), aggregateTimeout)
+	defer cancel()
+	res, err := rs.Browse(ctx, id, flag, start, count)
+	if err != nil {
+		var ue *soap.UPnPError
+		if errors.As(err, &ue) {
+			err = upnp.Errorf(ue.Code, "%s", ue.Desc)
+			return
+		}
+		var fe *soap.FaultError
+		if !errors.As(err, &fe) && r.Context().Err() == nil {
+			log.Printf("dropping aggregated server %q: %s", rs.FriendlyName, err)
+			a.drop(rs)
+		}
+		err = upnp.Errorf(upnpav.NoSuchObjectErrorCode, "%s: %s", rs.FriendlyName, err)
+		return
+	}
+	objs = res.Result.Objects
+	for i, obj := range objs {
+		switch o := obj.(type) {
+		case upnpav.Container:
+			a.rewriteRemoteObject(rs, &o.Object, r.Host)
+			objs[i] = o
+		case upnpav.Item:
+			a.rewriteRemoteObject(rs, &o.Object, r.Host)
+			if o.RefID != "" {
+				o.RefID = remoteObjectID(rs.UDN, o.RefID)
+			}
+			for j := range o.Res {
+				o.Res[j].URL = a.proxyURL(r.Host, rs.UDN, o.Res[j].URL)
+				o.Res[j].ImportURI = ""
+			}
+			objs[i] = o
+		}
+	}
+	return objs, int(res.TotalMatches), fmt.Sprint(res.UpdateID), nil
+}
+
+// Whether the URL is an http URL on the server's host, where the server's
+// resources can be proxied from.
+func (rs *remoteServer) isResourceURL(u *url.URL) bool {
+	loc, err := url.Parse(rs.Location)
+	return err == nil && u.Scheme == "http" && u.Hostname() != "" && strings.EqualFold(u.Hostname(), loc.Hostname())
+}
+
+// Proxies a resource of an aggregated server. Only URLs that this server
+// handed out in Browse results are proxied, and only those on the server's
+// host, so that servers can't have other hosts fetched.
+func (a *aggregator) serveProxy(w http.ResponseWriter, r *http.Request) {
+	if !a.srv.accessRule(r).allowsAggregated() {
+		http.Error(w, "no such server", http.StatusNotFound)
+		return
+	}
+	q := r.URL.Query()
+	udn, u := q.Get("server"), q.Get("url")
+	sig, err := hex.DecodeString(q.Get("sig"))
+	if err != nil || !hmac.Equal(sig, a.sign(udn, u)) {
+		http.Error(w, "not a resource of the server", http.StatusForbidden)
+		return
+	}
+	rs := a.server(udn)
+	if rs == nil {
+		http.Error(w, "no such server", http.StatusNotFound)
+		return
+	}
+	target, err := url.Parse(u)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !rs.isResourceURL(target) {
+		http.Error(w, "not a resource of the server", http.StatusForbidden)
+		return
+	}
+	rp := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL = target
+			req.Host = target.Host
+			// The client's credentials are for this server.
+			req.Header.Del("Authorization")
+			req.Header.Del("Cookie")
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			log.Printf("error proxying %s: %s", target, err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+		},
+	}
+	rp.ServeHTTP(w, r)
+}
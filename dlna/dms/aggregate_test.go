@@ -0,0 +1,213 @@
+package dms
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/soap"
+	"github.com/anacrolix/dms/ssdp"
+	"github.com/anacrolix/dms/upnp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+const remoteServerDesc = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:MediaServer:1</deviceType>
+    <friendlyName>NAS</friendlyName>
+    <UDN>uuid:nas</UDN>
+    <serviceList>
+      <service>
+        <serviceType>urn:schemas-upnp-org:service:ContentDirectory:1</serviceType>
+        <controlURL>/cds</controlURL>
+      </service>
+    </serviceList>
+  </device>
+</root>`
+
+// Serves a MediaServer with a folder holding a song.
+func serveRemoteServer(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/desc.xml":
+		w.Write([]byte(remoteServerDesc))
+	case "/a.mp3":
+		if r.Header.Get("Authorization") != "" || r.Header.Get("Cookie") != "" {
+			http.Error(w, "got the client's credentials", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Write([]byte("song"))
+	case "/cds":
+		body, _ := soap.ReadEnvelope(r.Body)
+		a, _ := soap.UnmarshalAction(body.Action)
+		id, _ := a.Arg("ObjectID")
+		flag, _ := a.Arg("BrowseFlag")
+		var objs []interface{}
+		switch {
+		case id == "0" && flag == dmc.BrowseMetadata:
+			objs = append(objs, upnpav.Container{Object: upnpav.Object{
+				ID: "0", ParentID: "-1", Title: "root", Class: "object.container",
+			}})
+		case id == "0":
+			objs = append(objs, upnpav.Container{Object: upnpav.Object{
+				ID: "music", ParentID: "0", Title: "Music", Class: "object.container.storageFolder",
+			}})
+		case id == "music":
+			objs = append(objs, upnpav.Item{
+				Object: upnpav.Object{
+					ID: "music/a", ParentID: "music", Title: "a", Class: "object.item.audioItem",
+				},
+				Res: []upnpav.Resource{{URL: "http://" + r.Host + "/a.mp3", ProtocolInfo: "http-get:*:audio/mpeg:*"}},
+			})
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(soap.MarshalEnvelope(soap.MarshalUPnPFault(upnpav.NoSuchObjectErrorCode, "No such object")))
+			return
+		}
+		result, _ := upnpav.MarshalDIDLLite(objs...)
+		w.Write(soap.MarshalEnvelope(soap.MarshalAction(a.XMLName.Space, "BrowseResponse", []soap.Arg{
+			soap.NewArg("Result", string(result)),
+			soap.NewArg("NumberReturned", "1"),
+			soap.NewArg("TotalMatches", "1"),
+			soap.NewArg("UpdateID", "1"),
+		})))
+	}
+}
+
+func TestRemoteObjectID(t *testing.T) {
+	id := remoteObjectID("uuid:a:b", "x:y/z")
+	if _, err := (&contentDirectoryService{}).objectFromID(id); err == nil {
+		t.Errorf("%q is a local ObjectID", id)
+	}
+	if udn, rid, ok := parseRemoteObjectID(id); !ok || udn != "uuid:a:b" || rid != "x:y/z" {
+		t.Errorf("got %q %q %v", udn, rid, ok)
+	}
+	if _, _, ok := parseRemoteObjectID("%2Fremote%3Ax"); ok {
+		t.Error("parsed a local ObjectID")
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	remote := httptest.NewServer(http.HandlerFunc(serveRemoteServer))
+	defer remote.Close()
+	ms, err := dmc.NewMediaServer(context.Background(), remote.URL+"/desc.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &Server{
+		RootObjectPath: t.TempDir(),
+		AccessRules:    []AccessRule{{ClientMatch: ClientMatch{UserAgents: []string{"KidsTV"}}, Paths: []string{"/Kids"}}},
+	}
+	a := newAggregator(srv)
+	srv.aggregator = a
+	a.reg.Handle(ssdp.Advertisement{Type: dmc.MediaServerType, USN: "uuid:nas::" + dmc.MediaServerType, Location: ms.Location, MaxAge: time.Minute})
+	a.servers[ms.UDN] = &remoteServer{ms, "uuid:nas::" + dmc.MediaServerType}
+	cds := &contentDirectoryService{Server: srv}
+	browse := func(id, flag string) (upnpav.DIDLLite, error) {
+		out, err := cds.browse(upnp.Args{"ObjectID": id, "BrowseFlag": flag}, httptest.NewRequest("POST", "http://dms/ctl", nil))
+		if err != nil {
+			return upnpav.DIDLLite{}, err
+		}
+		return upnpav.UnmarshalDIDLLite([]byte(out["Result"]))
+	}
+	root, err := browse("0", dmc.BrowseDirectChildren)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := root.Containers()
+	if len(cs) != 1 || cs[0].Title != "NAS" || cs[0].ParentID != "0" {
+		t.Fatalf("wrong root: %+v", root)
+	}
+	meta, err := browse(cs[0].ID, dmc.BrowseMetadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := meta.Containers(); len(c) != 1 || c[0].ID != cs[0].ID || c[0].Title != "NAS" {
+		t.Errorf("wrong metadata: %+v", meta)
+	}
+	folders, err := browse(cs[0].ID, dmc.BrowseDirectChildren)
+	if err != nil {
+		t.Fatal(err)
+	}
+	music := folders.Containers()[0]
+	if music.ParentID != cs[0].ID {
+		t.Errorf("wrong parent %q", music.ParentID)
+	}
+	songs, err := browse(music.ID, dmc.BrowseDirectChildren)
+	if err != nil {
+		t.Fatal(err)
+	}
+	song := songs.Items()[0]
+	if song.ParentID != music.ID || !strings.HasPrefix(song.Res[0].URL, "http://dms"+proxyPath+"?") {
+		t.Fatalf("wrong song: %+v", song)
+	}
+	if _, err := browse(remoteObjectID(ms.UDN, "nope"), dmc.BrowseMetadata); upnp.ConvertError(err).Code != upnpav.NoSuchObjectErrorCode {
+		t.Errorf("got %v", err)
+	}
+
+	// The resource is proxied, and only the server's are.
+	u, _ := url.Parse(song.Res[0].URL)
+	r := httptest.NewRequest("GET", u.RequestURI(), nil)
+	r.Header.Set("Authorization", "Basic YWRtaW46c2VjcmV0")
+	r.Header.Set("Cookie", "session=1")
+	w := httptest.NewRecorder()
+	a.serveProxy(w, r)
+	if b, _ := ioutil.ReadAll(w.Body); w.Code != http.StatusOK || string(b) != "song" || w.Header().Get("Content-Type") != "audio/mpeg" {
+		t.Errorf("proxy got %d %q", w.Code, b)
+	}
+	for _, other := range []string{"http://elsewhere/a.mp3", remote.URL + "/desc.xml"} {
+		q := u.Query()
+		q.Set("url", other)
+		w = httptest.NewRecorder()
+		a.serveProxy(w, httptest.NewRequest("GET", proxyPath+"?"+q.Encode(), nil))
+		if w.Code != http.StatusForbidden {
+			t.Errorf("proxied %s: %d", other, w.Code)
+		}
+	}
+	// Servers can't have other hosts fetched by handing out their URLs.
+	for _, other := range []string{"http://127.0.0.2/a.mp3", "http://elsewhere" + strings.TrimPrefix(remote.URL, "http://127.0.0.1") + "/a.mp3", "file:///etc/passwd"} {
+		q := u.Query()
+		q.Set("url", other)
+		q.Set("sig", hex.EncodeToString(a.sign(ms.UDN, other)))
+		w = httptest.NewRecorder()
+		a.serveProxy(w, httptest.NewRequest("GET", proxyPath+"?"+q.Encode(), nil))
+		if w.Code != http.StatusForbidden {
+			t.Errorf("proxied %s: %d", other, w.Code)
+		}
+	}
+	// Clients that can't see the aggregated servers can't use the proxy.
+	r = httptest.NewRequest("GET", u.RequestURI(), nil)
+	r.Header.Set("User-Agent", "KidsTV")
+	w = httptest.NewRecorder()
+	a.serveProxy(w, r)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("proxied for a restricted client: %d", w.Code)
+	}
+	q := u.Query()
+	q.Del("sig")
+	w = httptest.NewRecorder()
+	a.serveProxy(w, httptest.NewRequest("GET", proxyPath+"?"+q.Encode(), nil))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("proxied without a signature: %d", w.Code)
+	}
+
+	// A server that's gone is dropped.
+	remote.Close()
+	if _, err := browse(music.ID, dmc.BrowseDirectChildren); err == nil {
+		t.Fatal("browsed a closed server")
+	}
+	if root, _ := browse("0", dmc.BrowseDirectChildren); len(root.Objects) != 0 {
+		t.Errorf("server still aggregated: %+v", root)
+	}
+	if len(a.reg.Entries(ssdp.All)) != 0 {
+		t.Error("server still registered")
+	}
+}
@@ -0,0 +1,247 @@
+package dms
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/upnp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+const (
+	objectsPath = "/api/v1/objects"
+	searchPath  = "/api/v1/search"
+)
+
+// A res element. Durations are in seconds.
+type resourceJSON struct {
+	URL          string  `json:"url"`
+	ProtocolInfo string  `json:"protocolInfo"`
+	MimeType     string  `json:"mimeType"`
+	Size         uint64  `json:"size,omitempty"`
+	Bitrate      uint    `json:"bitrate,omitempty"`
+	Duration     float64 `json:"duration,omitempty"`
+	Resolution   string  `json:"resolution,omitempty"`
+}
+
+// A ContentDirectory object, with the properties dms sets.
+type objectJSON struct {
+	ID          string         `json:"id"`
+	ParentID    string         `json:"parentId"`
+	Title       string         `json:"title"`
+	Class       string         `json:"class"`
+	Container   bool           `json:"container"`
+	Artist      []string       `json:"artist,omitempty"`
+	Album       string         `json:"album,omitempty"`
+	Genre       []string       `json:"genre,omitempty"`
+	Date        string         `json:"date,omitempty"`
+	AlbumArtURI string         `json:"albumArtUri,omitempty"`
+	Resources   []resourceJSON `json:"resources,omitempty"`
+}
+
+// A page of objects. Total is the number there are.
+type objectsJSON struct {
+	Objects []objectJSON `json:"objects"`
+	Start   uint         `json:"start"`
+	Total   int          `json:"total"`
+}
+
+// Converts a Container or Item to JSON.
+func newObjectJSON(obj interface{}) objectJSON {
+	o := upnpavObject(obj)
+	ret := objectJSON{
+		ID:       o.ID,
+		ParentID: o.ParentID,
+		Title:    o.Title,
+		Class:    o.Class,
+		Album:    o.Album,
+		Genre:    o.Genre,
+		Date:     o.Date,
+	}
+	if len(o.AlbumArtURI) != 0 {
+		ret.AlbumArtURI = o.AlbumArtURI[0].URI
+	}
+	for _, a := range o.Artist {
+		ret.Artist = append(ret.Artist, a.Name)
+	}
+	switch o := obj.(type) {
+	case upnpav.Container:
+		ret.Container = true
+	case upnpav.Item:
+		for _, r := range o.Res {
+			rj := resourceJSON{
+				URL:          r.URL,
+				ProtocolInfo: r.ProtocolInfo,
+				Size:         r.Size,
+				Bitrate:      r.Bitrate,
+				Resolution:   r.Resolution,
+			}
+			// The third field of protocol info is the content format.
+			if ss := strings.Split(r.ProtocolInfo, ":"); len(ss) == 4 {
+				rj.MimeType = ss[2]
+			}
+			if d, err := dmc.ParseTime(r.Duration); err == nil {
+				rj.Duration = d.Seconds()
+			}
+			ret.Resources = append(ret.Resources, rj)
+		}
+	}
+	return ret
+}
+
+func newObjectsJSON(objs []interface{}, start uint, total int) objectsJSON {
+	ret := objectsJSON{
+		Objects: make([]objectJSON, 0, len(objs)),
+		Start:   start,
+		Total:   total,
+	}
+	for _, obj := range objs {
+		ret.Objects = append(ret.Objects, newObjectJSON(obj))
+	}
+	return ret
+}
+
+// Returns the start and count parameters, and the sort parameter as
+// SortCriteria.
+func pageParams(r *http.Request) (start, count uint, sortCriteria string, err error) {
+	for _, p := range []struct {
+		name string
+		dest *uint
+	}{{"start", &start}, {"count", &count}} {
+		s := r.FormValue(p.name)
+		if s == "" {
+			continue
+		}
+		n, perr := strconv.ParseUint(s, 10, 32)
+		if perr != nil {
+			err = fmt.Errorf("bad %s: %s", p.name, perr)
+			return
+		}
+		*p.dest = uint(n)
+	}
+	sortCriteria = r.FormValue("sort")
+	return
+}
+
+// Responds with the status for an error from the ContentDirectory.
+func writeObjectsError(w http.ResponseWriter, err error) {
+	var ue *upnp.Error
+	switch {
+	case errors.As(err, &ue) && ue.Code == upnpav.NoSuchObjectErrorCode,
+		os.IsNotExist(err), errors.Is(err, errNotContainer):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.As(err, &ue) && ue.Code == upnpav.InvalidSortCriteriaErrorCode:
+		http.Error(w, ue.Desc, http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Handles GET <objectsPath>/<id> for an object, and
+// <objectsPath>/<id>/children for a page of a container's children, given by
+// start and count parameters. IDs are path escaped. The sort parameter is as
+// the Browse SortCriteria, such as "-dc:date,+dc:title". Objects are as
+// Browse returns them. <objectsPath>/<id>/playlist.m3u and playlist.xspf are
+// a container's items as a playlist.
+func (srv *Server) serveObject(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	p := strings.TrimPrefix(r.URL.EscapedPath(), objectsPath+"/")
+	flag := dmc.BrowseMetadata
+	var playlistFormat string
+	if strings.HasSuffix(p, "/children") {
+		p = strings.TrimSuffix(p, "/children")
+		flag = dmc.BrowseDirectChildren
+	}
+	for format := range playlistContentTypes {
+		if strings.HasSuffix(p, "/playlist."+format) {
+			p = strings.TrimSuffix(p, "/playlist."+format)
+			playlistFormat = format
+		}
+	}
+	id, err := url.PathUnescape(p)
+	if err != nil || id == "" || strings.Contains(p, "/") {
+		http.Error(w, "no such object", http.StatusNotFound)
+		return
+	}
+	if playlistFormat != "" {
+		srv.servePlaylist(w, r, id, playlistFormat)
+		return
+	}
+	start, count, sortCriteria, err := pageParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cds := &contentDirectoryService{Server: srv}
+	objs, total, _, err := cds.browseObjects(r, id, flag, sortCriteria, start, count)
+	if err != nil {
+		writeObjectsError(w, err)
+		return
+	}
+	if flag == dmc.BrowseMetadata {
+		// Aggregated servers might not return it.
+		if len(objs) == 0 {
+			http.Error(w, "no such object", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, newObjectJSON(objs[0]))
+		return
+	}
+	writeJSON(w, newObjectsJSON(objs, start, total))
+}
+
+// Handles GET <searchPath>?q=... for the objects below a container, the root
+// by default or given by the container parameter, whose titles contain q.
+// Paging and sorting are as for children.
+func (srv *Server) serveSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	q := r.FormValue("q")
+	if q == "" {
+		http.Error(w, "missing q", http.StatusBadRequest)
+		return
+	}
+	start, count, sortCriteria, err := pageParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	containerID := r.FormValue("container")
+	if containerID == "" {
+		containerID = "0"
+	}
+	if _, _, ok := parseRemoteObjectID(containerID); ok {
+		http.Error(w, "aggregated servers can't be searched", http.StatusBadRequest)
+		return
+	}
+	cds := &contentDirectoryService{Server: srv}
+	access := srv.accessRule(r)
+	o, err := cds.objectFromID(containerID)
+	if err == nil && !access.allows(o, true) {
+		err = fmt.Errorf("no such object %q", containerID)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	objs, err := cds.searchContainer(o, q, r.Host, cds.profile(r), access)
+	if err == nil {
+		err = sortObjects(objs, sortCriteria)
+	}
+	if err != nil {
+		writeObjectsError(w, err)
+		return
+	}
+	writeJSON(w, newObjectsJSON(page(objs, start, count), start, len(objs)))
+}
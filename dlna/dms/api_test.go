@@ -0,0 +1,132 @@
+package dms
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anacrolix/dms/upnp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+// Writes the files, by slash-separated paths relative to dir, with their
+// contents.
+func writeTestTree(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func newAPITestServer(t *testing.T) *Server {
+	dir := t.TempDir()
+	writeTestTree(t, dir, map[string]string{"a.mp3": "data", "sub/b.mp3": "data", "sub/notes.txt": "data"})
+	return &Server{RootObjectPath: dir, NoProbe: true, NoTranscode: true}
+}
+
+// Makes a GET request, decoding a successful response into v.
+func getJSON(t *testing.T, h http.HandlerFunc, target string, v interface{}) int {
+	t.Helper()
+	w := httptest.NewRecorder()
+	h(w, httptest.NewRequest("GET", "http://dms"+target, nil))
+	if w.Code == http.StatusOK {
+		if err := json.NewDecoder(w.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return w.Code
+}
+
+func objectIDs(l objectsJSON) (ret []string) {
+	for _, o := range l.Objects {
+		ret = append(ret, o.ID)
+	}
+	return
+}
+
+func TestObjectsAPI(t *testing.T) {
+	srv := newAPITestServer(t)
+	var l objectsJSON
+	if code := getJSON(t, srv.serveObject, objectsPath+"/0/children", &l); code != http.StatusOK {
+		t.Fatal(code)
+	}
+	if ids := objectIDs(l); l.Total != 2 || len(ids) != 2 || ids[0] != "%2Fsub" || ids[1] != "%2Fa.mp3" {
+		t.Fatalf("wrong children: %+v", l)
+	}
+	res := l.Objects[1].Resources
+	if len(res) != 1 || res[0].URL != "http://dms/res?path=%2Fa.mp3" || res[0].MimeType != "audio/mpeg" || res[0].Size != 4 {
+		t.Errorf("wrong resources: %+v", res)
+	}
+
+	// The same objects as Browse.
+	out, err := (&contentDirectoryService{Server: srv}).browse(upnp.Args{
+		"ObjectID":   "0",
+		"BrowseFlag": "BrowseDirectChildren",
+	}, httptest.NewRequest("POST", "http://dms/ctl", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := upnpav.UnmarshalDIDLLite([]byte(out["Result"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, obj := range d.Objects {
+		if o := upnpavObject(obj); o.ID != l.Objects[i].ID || o.Title != l.Objects[i].Title {
+			t.Errorf("Browse returned %+v", o)
+		}
+	}
+
+	l = objectsJSON{}
+	getJSON(t, srv.serveObject, objectsPath+"/0/children?sort=%2Bdc:title&start=1&count=1", &l)
+	if ids := objectIDs(l); l.Total != 2 || l.Start != 1 || len(ids) != 1 || ids[0] != "%2Fsub" {
+		t.Errorf("wrong page: %+v", l)
+	}
+	var o objectJSON
+	if code := getJSON(t, srv.serveObject, objectsPath+"/%252Fsub", &o); code != http.StatusOK || !o.Container || o.Title != "sub" || o.ParentID != "0" {
+		t.Errorf("got %d %+v", code, o)
+	}
+	for target, expected := range map[string]int{
+		objectsPath + "/%252Fnope":                       http.StatusNotFound,
+		objectsPath + "/%252Fsub%252Fnotes.txt":          http.StatusNotFound,
+		objectsPath + "/0/children?sort=upnp:rating":     http.StatusBadRequest,
+		objectsPath + "/0/children?count=-1":             http.StatusBadRequest,
+		objectsPath + "/%252Fa.mp3/children":             http.StatusNotFound,
+		objectsPath + "/%252Fsub/children?sort=-dc:date": http.StatusOK,
+	} {
+		if code := getJSON(t, srv.serveObject, target, &objectsJSON{}); code != expected {
+			t.Errorf("%s: got %d", target, code)
+		}
+	}
+}
+
+func TestSearchAPI(t *testing.T) {
+	srv := newAPITestServer(t)
+	var l objectsJSON
+	if code := getJSON(t, srv.serveSearch, searchPath+"?q=B.MP", &l); code != http.StatusOK {
+		t.Fatal(code)
+	}
+	if ids := objectIDs(l); l.Total != 1 || len(ids) != 1 || ids[0] != "%2Fsub%2Fb.mp3" {
+		t.Errorf("wrong results: %+v", l)
+	}
+	l = objectsJSON{}
+	getJSON(t, srv.serveSearch, searchPath+"?q=.&container=%252Fsub", &l)
+	if ids := objectIDs(l); len(ids) != 1 || ids[0] != "%2Fsub%2Fb.mp3" {
+		t.Errorf("wrong results: %+v", l)
+	}
+	if code := getJSON(t, srv.serveSearch, searchPath, &l); code != http.StatusBadRequest {
+		t.Errorf("got %d without q", code)
+	}
+	if code := getJSON(t, srv.serveSearch, searchPath+"?q=a&container=%252Fa.mp3", &l); code != http.StatusNotFound {
+		t.Errorf("searched an item: %d", code)
+	}
+}
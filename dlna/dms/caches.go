@@ -0,0 +1,113 @@
+package dms
+
+import (
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/anacrolix/dms/rrcache"
+	"github.com/anacrolix/ffprobe"
+)
+
+const (
+	// Bytes of generated thumbnails kept in memory.
+	thumbnailCacheCapacity = 32 << 20
+	// Directory entries kept in memory.
+	dirListingCacheCapacity = 100000
+	// Changes to files don't change their directory's modification time, so
+	// listings are only trusted for a while.
+	dirListingTTL = time.Minute
+	// Probe results kept in memory when no FFProbeCache is given.
+	memoryProbeCacheCapacity = 100000
+)
+
+type thumbnailKey struct {
+	Path    string
+	ModTime int64
+	// The ffmpegthumbnailer output codec.
+	Codec string
+}
+
+type dirListing struct {
+	modTime time.Time
+	fis     []os.FileInfo
+}
+
+// Keeps probe results in memory, for the background scanner when the
+// server isn't given an FFProbeCache.
+type memoryFFProbeCache struct {
+	cache *rrcache.Cache[FfprobeCacheKey, *ffprobe.Info]
+}
+
+func newMemoryFFProbeCache() memoryFFProbeCache {
+	return memoryFFProbeCache{rrcache.NewCache(memoryProbeCacheCapacity, rrcache.Options[FfprobeCacheKey, *ffprobe.Info]{
+		Policy: rrcache.LRU,
+	})}
+}
+
+func (c memoryFFProbeCache) Set(key interface{}, value interface{}) {
+	c.cache.Set(key.(FfprobeCacheKey), value.(*ffprobe.Info), 1)
+}
+
+func (c memoryFFProbeCache) Get(key interface{}) (interface{}, bool) {
+	return c.cache.Get(key.(FfprobeCacheKey))
+}
+
+func (srv *Server) initCaches() {
+	srv.thumbnails = rrcache.NewCache(thumbnailCacheCapacity, rrcache.Options[thumbnailKey, []byte]{
+		Policy: rrcache.LRU,
+	})
+	srv.dirListings = rrcache.NewCache(dirListingCacheCapacity, rrcache.Options[string, dirListing]{
+		Policy: rrcache.ARC,
+		TTL:    dirListingTTL,
+	})
+	srv.sinks = newSinkCache()
+}
+
+// Returns a thumbnail for the file at path, encoded with the given
+// ffmpegthumbnailer codec.
+func (srv *Server) thumbnail(path, codec string) ([]byte, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	key := thumbnailKey{path, fi.ModTime().UnixNano(), codec}
+	if srv.thumbnails != nil {
+		if b, ok := srv.thumbnails.Get(key); ok {
+			return b, nil
+		}
+	}
+	cmd := exec.Command("ffmpegthumbnailer", "-i", path, "-o", "/dev/stdout", "-c"+codec)
+	// cmd.Stderr = os.Stderr
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	if srv.thumbnails != nil {
+		srv.thumbnails.Set(key, b, int64(len(b)))
+	}
+	return b, nil
+}
+
+// Returns the entries of an object's directory. Cached listings are used
+// while the directory is unmodified. The returned slice is the caller's to
+// modify.
+func (srv *Server) readDir(o object) (fis []os.FileInfo, err error) {
+	if srv.dirListings == nil {
+		return o.readDir()
+	}
+	dirPath := o.FilePath()
+	fi, err := os.Stat(dirPath)
+	if err != nil {
+		return
+	}
+	if l, ok := srv.dirListings.Get(dirPath); ok && l.modTime.Equal(fi.ModTime()) {
+		return append([]os.FileInfo(nil), l.fis...), nil
+	}
+	fis, err = o.readDir()
+	if err != nil {
+		return
+	}
+	srv.dirListings.Set(dirPath, dirListing{fi.ModTime(), fis}, int64(len(fis))+1)
+	return append([]os.FileInfo(nil), fis...), nil
+}
@@ -0,0 +1,394 @@
+package dms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/ssdp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+var (
+	errNotMedia = errors.New("not a media file")
+	// The client's access rule doesn't allow the object.
+	errNoSuchObject = errors.New("no such object")
+)
+
+const (
+	renderersPath = "/api/v1/renderers"
+	// Time renderers have to respond to searches made by the API.
+	rendererSearchMX = 2 * time.Second
+	// Time allowed for API calls to renderers.
+	rendererTimeout = 10 * time.Second
+)
+
+// Returns the item for the file at the object path, with resources served
+// from host, as the renderer's profile wants them. Only media files that the
+// access rule allows can be items.
+func (srv *Server) castItem(objectPath, host string, profile *Profile, access *AccessRule) (item upnpav.Item, err error) {
+	o, filePath, err := srv.filePath(objectPath)
+	if err != nil {
+		return
+	}
+	if !access.allows(o, false) {
+		err = fmt.Errorf("%s: %w", o.Path, errNoSuchObject)
+		return
+	}
+	fi, err := os.Stat(filePath)
+	if err != nil {
+		return
+	}
+	cds := &contentDirectoryService{Server: srv}
+	obj, err := cds.cdsObjectToUpnpavObject(o, fi, host, profile, access)
+	if err != nil {
+		return
+	}
+	item, ok := obj.(upnpav.Item)
+	if !ok {
+		err = fmt.Errorf("%s: %w", o.Path, errNotMedia)
+	}
+	return
+}
+
+// Cast has the renderer play the file at the object path, which is relative
+// to the RootObjectPath, or starts with a root's name if there are Roots.
+// The renderer fetches it from the server's resource handler, so the server
+// must be serving.
+func (srv *Server) Cast(ctx context.Context, r *dmc.Renderer, objectPath string) error {
+	return srv.cast(ctx, r, objectPath, nil)
+}
+
+// Casts for a client, which can only cast what its access rule allows.
+func (srv *Server) cast(ctx context.Context, r *dmc.Renderer, objectPath string, access *AccessRule) error {
+	host, err := srv.rendererHost(r)
+	if err != nil {
+		return err
+	}
+	item, err := srv.castItem(objectPath, host, srv.rendererProfile(ctx, r), access)
+	if err != nil {
+		return err
+	}
+	metadata, err := upnpav.MarshalDIDLLite(item)
+	if err != nil {
+		return err
+	}
+	// The first resource is the one the renderer is most likely to play.
+	if err := r.SetAVTransportURI(ctx, item.Res[0].URL, string(metadata)); err != nil {
+		return err
+	}
+	return r.Play(ctx)
+}
+
+// Returns the host the renderer can reach the server at.
+func (srv *Server) rendererHost(r *dmc.Renderer) (string, error) {
+	ip, err := r.LocalIP()
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(ip.String(), strconv.Itoa(srv.httpPort())), nil
+}
+
+// The SSDP client used to find renderers, on the server's interface if
+// there's only one.
+func (srv *Server) ssdpClient() *ssdp.Client {
+	c := &ssdp.Client{}
+	if len(srv.Interfaces) == 1 {
+		if ifi, err := net.InterfaceByName(srv.Interfaces[0].Name); err == nil {
+			c.Interface = ifi
+		}
+	}
+	return c
+}
+
+// Searches for renderers, and remembers them by UDN for the API calls that
+// follow.
+func (srv *Server) discoverRenderers(ctx context.Context) ([]*dmc.Renderer, error) {
+	rs, err := dmc.Discover(ctx, srv.ssdpClient(), rendererSearchMX)
+	if err != nil {
+		return nil, err
+	}
+	srv.renderersMu.Lock()
+	defer srv.renderersMu.Unlock()
+	if srv.renderers == nil {
+		srv.renderers = make(map[string]*dmc.Renderer)
+	}
+	for _, r := range rs {
+		srv.renderers[r.UDN] = r
+	}
+	return rs, nil
+}
+
+// Returns the renderer with the given UDN or name, searching for it if it
+// hasn't been seen.
+func (srv *Server) renderer(ctx context.Context, id string) (*dmc.Renderer, error) {
+	srv.renderersMu.Lock()
+	r, ok := srv.renderers[id]
+	srv.renderersMu.Unlock()
+	if ok {
+		return r, nil
+	}
+	rs, err := srv.discoverRenderers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rs {
+		if r.Match(id) {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+type rendererJSON struct {
+	UDN      string `json:"udn"`
+	Name     string `json:"name"`
+	Location string `json:"location"`
+}
+
+func newRendererJSON(r *dmc.Renderer) rendererJSON {
+	return rendererJSON{r.UDN, r.FriendlyName, r.Location}
+}
+
+// Times are in seconds. Volume is left out if the renderer has no
+// RenderingControl.
+type rendererStatusJSON struct {
+	rendererJSON
+	State    string  `json:"state"`
+	Status   string  `json:"status"`
+	Track    uint    `json:"track"`
+	URI      string  `json:"uri"`
+	Position float64 `json:"position"`
+	Duration float64 `json:"duration"`
+	Volume   *uint   `json:"volume,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Print(err)
+	}
+}
+
+// Lists the renderers on the network.
+func (srv *Server) serveRenderers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	rs, err := srv.discoverRenderers(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ret := make([]rendererJSON, 0, len(rs))
+	for _, r := range rs {
+		ret = append(ret, newRendererJSON(r))
+	}
+	writeJSON(w, ret)
+}
+
+// Handles GET <renderersPath>/<udn> for the renderer's status, and POST
+// <renderersPath>/<udn>/<action> to control it. The actions are cast, with
+// a path parameter, play, pause, stop, seek, with a position in seconds or
+// H:MM:SS, and volume, with a volume parameter.
+//
+// The renderer's play queue is at <renderersPath>/<udn>/queue. GET returns
+// it, POST replaces it with the items of the container parameter, a CDS
+// ObjectID, and DELETE stops it. The queue actions are queue/next,
+// queue/previous and queue/mode. POST and queue/mode take shuffle and repeat
+// parameters, where repeat is off, all or one.
+func (srv *Server) serveRenderer(w http.ResponseWriter, r *http.Request) {
+	id, action := strings.TrimPrefix(r.URL.Path, renderersPath+"/"), ""
+	if i := strings.IndexByte(id, '/'); i >= 0 {
+		id, action = id[:i], id[i+1:]
+	}
+	method := "POST"
+	switch {
+	case action == "":
+		method = "GET"
+	case action == "queue" && (r.Method == "GET" || r.Method == "DELETE"):
+		method = r.Method
+	}
+	if r.Method != method {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), rendererTimeout)
+	defer cancel()
+	rend, err := srv.renderer(ctx, id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if rend == nil {
+		http.Error(w, "no such renderer", http.StatusNotFound)
+		return
+	}
+	if strings.HasPrefix(action, "queue") {
+		srv.serveQueue(ctx, w, r, rend, action)
+		return
+	}
+	switch action {
+	case "":
+		srv.serveRendererStatus(ctx, w, rend)
+		return
+	case "cast":
+		srv.setQueue(rend.UDN, nil)
+		err = srv.cast(ctx, rend, r.FormValue("path"), srv.accessRule(r))
+	case "play":
+		err = rend.Play(ctx)
+	case "pause":
+		err = rend.Pause(ctx)
+	case "stop":
+		// Otherwise the queue would take it as the end of an item.
+		srv.setQueue(rend.UDN, nil)
+		err = rend.Stop(ctx)
+	case "seek":
+		pos, perr := parsePosition(r.FormValue("position"))
+		if perr != nil {
+			http.Error(w, perr.Error(), http.StatusBadRequest)
+			return
+		}
+		err = rend.Seek(ctx, pos)
+	case "volume":
+		v, perr := strconv.ParseUint(r.FormValue("volume"), 10, 16)
+		if perr != nil {
+			http.Error(w, fmt.Sprintf("bad volume: %s", perr), http.StatusBadRequest)
+			return
+		}
+		err = rend.SetVolume(ctx, uint(v))
+	default:
+		http.Error(w, "no such action", http.StatusNotFound)
+		return
+	}
+	writeActionResult(w, err)
+}
+
+// Responds to an action on a renderer.
+func writeActionResult(w http.ResponseWriter, err error) {
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	// The media is the problem, rather than the renderer.
+	case os.IsNotExist(err), errors.Is(err, errNoSuchObject), errors.Is(err, errNotMedia), errors.Is(err, errNotContainer), errors.Is(err, errNoMedia):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, errQueueEnd):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusBadGateway)
+	}
+}
+
+// Returns the shuffle and repeat parameters, defaulting to the given mode.
+func queueMode(r *http.Request, shuffle bool, repeat string) (bool, string, error) {
+	if s := r.FormValue("shuffle"); s != "" {
+		var err error
+		if shuffle, err = strconv.ParseBool(s); err != nil {
+			return false, "", fmt.Errorf("bad shuffle: %s", err)
+		}
+	}
+	if s := r.FormValue("repeat"); s != "" {
+		switch s {
+		case repeatOff, repeatAll, repeatOne:
+			repeat = s
+		default:
+			return false, "", fmt.Errorf("bad repeat %q", s)
+		}
+	}
+	return shuffle, repeat, nil
+}
+
+func (srv *Server) serveQueue(ctx context.Context, w http.ResponseWriter, r *http.Request, rend *dmc.Renderer, action string) {
+	q := srv.queue(rend.UDN)
+	if action == "queue" && r.Method == "POST" {
+		shuffle, repeat, err := queueMode(r, false, repeatOff)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if q != nil {
+			q.close()
+		}
+		q, err = srv.startQueue(ctx, rend, r.FormValue("container"), shuffle, repeat, srv.accessRule(r))
+		if err != nil {
+			writeActionResult(w, err)
+			return
+		}
+		writeJSON(w, q.json())
+		return
+	}
+	if q == nil {
+		http.Error(w, "no queue", http.StatusNotFound)
+		return
+	}
+	var err error
+	switch action {
+	case "queue":
+		if r.Method == "GET" {
+			writeJSON(w, q.json())
+			return
+		}
+		srv.setQueue(rend.UDN, nil)
+		err = q.stop(ctx)
+	case "queue/next":
+		err = q.skip(ctx, 1)
+	case "queue/previous":
+		err = q.skip(ctx, -1)
+	case "queue/mode":
+		cur := q.json()
+		shuffle, repeat, perr := queueMode(r, cur.Shuffle, cur.Repeat)
+		if perr != nil {
+			http.Error(w, perr.Error(), http.StatusBadRequest)
+			return
+		}
+		q.setMode(ctx, shuffle, repeat)
+	default:
+		http.Error(w, "no such action", http.StatusNotFound)
+		return
+	}
+	writeActionResult(w, err)
+}
+
+func (srv *Server) serveRendererStatus(ctx context.Context, w http.ResponseWriter, r *dmc.Renderer) {
+	ti, err := r.TransportInfo(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	pi, err := r.PositionInfo(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	ret := rendererStatusJSON{
+		rendererJSON: newRendererJSON(r),
+		State:        ti.State,
+		Status:       ti.Status,
+		Track:        pi.Track,
+		URI:          pi.URI,
+		Position:     pi.RelTime.Seconds(),
+		Duration:     pi.Duration.Seconds(),
+	}
+	if v, err := r.Volume(ctx); err == nil {
+		ret.Volume = &v
+	}
+	writeJSON(w, ret)
+}
+
+// Parses a position given as seconds or H:MM:SS.
+func parsePosition(s string) (time.Duration, error) {
+	if secs, err := strconv.ParseFloat(s, 64); err == nil && secs >= 0 {
+		return time.Duration(secs * float64(time.Second)), nil
+	}
+	return dmc.ParseTime(s)
+}
@@ -0,0 +1,86 @@
+package dms
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCastItem(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"a.mp3", "notes.txt"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv := &Server{RootObjectPath: dir, NoProbe: true}
+	item, err := srv.castItem("a.mp3", "192.168.1.2:1338", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Title != "a.mp3" || item.Res[0].URL != "http://192.168.1.2:1338/res?path=%2Fa.mp3" {
+		t.Errorf("wrong item: %#v", item)
+	}
+	// Paths can't escape the root.
+	if _, err := srv.castItem("../a.mp3", "", nil, nil); err != nil {
+		t.Error(err)
+	}
+	for _, p := range []string{"/", "/notes.txt"} {
+		if _, err := srv.castItem(p, "", nil, nil); !errors.Is(err, errNotMedia) {
+			t.Errorf("%s: got %v", p, err)
+		}
+	}
+	if _, err := srv.castItem("/missing.mp3", "", nil, nil); !os.IsNotExist(err) {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestParsePosition(t *testing.T) {
+	for s, expected := range map[string]time.Duration{
+		"90":      90 * time.Second,
+		"1.5":     1500 * time.Millisecond,
+		"0:01:30": 90 * time.Second,
+	} {
+		if d, err := parsePosition(s); err != nil || d != expected {
+			t.Errorf("%q: got %s, %v", s, d, err)
+		}
+	}
+	for _, s := range []string{"", "-1", "soon"} {
+		if _, err := parsePosition(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
+
+// The renderer API is only served with an admin password, and requires it.
+func TestRendererAPIAuth(t *testing.T) {
+	mux := http.NewServeMux()
+	(&Server{}).initMux(mux)
+	if _, pattern := mux.Handler(httptest.NewRequest("POST", "http://dms"+renderersPath+"/uuid:tv/stop", nil)); pattern != "/" {
+		t.Fatalf("renderer API served without a password, at %q", pattern)
+	}
+	mux = http.NewServeMux()
+	(&Server{AdminPassword: "secret"}).initMux(mux)
+	for password, code := range map[string]int{
+		"wrong": http.StatusUnauthorized,
+		// The API's own response.
+		"secret": http.StatusMethodNotAllowed,
+	} {
+		r := httptest.NewRequest("POST", "http://dms"+renderersPath, nil)
+		r.SetBasicAuth("admin", password)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("password %q: got %d", password, w.Code)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package dms
 
 import (
-	"encoding/xml"
 	"fmt"
 	"log"
 	"net/http"
@@ -11,9 +10,11 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync/atomic"
 
 	"github.com/anacrolix/dms/dlna"
 	"github.com/anacrolix/dms/misc"
+	"github.com/anacrolix/dms/playlist"
 	"github.com/anacrolix/dms/upnp"
 	"github.com/anacrolix/dms/upnpav"
 	"github.com/anacrolix/ffprobe"
@@ -22,21 +23,31 @@ import (
 type contentDirectoryService struct {
 	*Server
 	upnp.Eventing
+	def *upnp.ServiceDef
 }
 
-func (cds *contentDirectoryService) updateIDString() string {
-	return fmt.Sprintf("%d", uint32(os.Getpid()))
+func newContentDirectoryService(srv *Server) *contentDirectoryService {
+	cds := &contentDirectoryService{Server: srv}
+	cds.def = cds.serviceDef()
+	return cds
 }
 
-// Turns the given entry and DMS host into a UPnP object. A nil object is
-// returned if the entry is not of interest.
-func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fileInfo os.FileInfo, host, userAgent string) (ret interface{}, err error) {
+func (srv *Server) updateIDString() string {
+	return fmt.Sprint(atomic.LoadUint32(&srv.systemUpdateID))
+}
+
+// Turns the given entry and DMS host into a UPnP object, as the client's
+// profile wants it. A nil object is returned if the entry is not of
+// interest, the client can't play it, or the access rule doesn't allow it.
+// Playlists are containers.
+func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fileInfo os.FileInfo, host string, profile *Profile, access *AccessRule) (ret interface{}, err error) {
 	entryFilePath := cdsObject.FilePath()
-	ignored, err := me.IgnorePath(entryFilePath)
+	isContainer := fileInfo.IsDir() || isPlaylist(fileInfo)
+	ignored, err := me.ignoreObject(cdsObject, isContainer)
 	if err != nil {
 		return
 	}
-	if ignored {
+	if ignored || !access.allows(cdsObject, isContainer) {
 		return
 	}
 	obj := upnpav.Object{
@@ -47,6 +58,15 @@ func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fil
 	if fileInfo.IsDir() {
 		obj.Class = "object.container.storageFolder"
 		obj.Title = fileInfo.Name()
+		if cdsObject.isNamedRoot() {
+			obj.Title = cdsObject.root.Name
+		}
+		ret = upnpav.Container{Object: obj}
+		return
+	}
+	if isPlaylist(fileInfo) {
+		obj.Class = "object.container.playlistContainer"
+		obj.Title = strings.TrimSuffix(fileInfo.Name(), path.Ext(fileInfo.Name()))
 		ret = upnpav.Container{Object: obj}
 		return
 	}
@@ -65,7 +85,7 @@ func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fil
 	iconURI := (&url.URL{
 		Scheme: "http",
 		Host:   host,
-		Path:   iconPath,
+		Path:   me.pathPrefix + iconPath,
 		RawQuery: url.Values{
 			"path": {cdsObject.Path},
 		}.Encode(),
@@ -73,7 +93,7 @@ func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fil
 	obj.Icon = iconURI
 	// TODO(anacrolix): This might not be necessary due to item res image
 	// element.
-	obj.AlbumArtURI = iconURI
+	obj.AlbumArtURI = []upnpav.AlbumArt{{URI: iconURI}}
 	obj.Class = "object.item." + mimeType.Type() + "Item"
 	var (
 		ffInfo        *ffprobe.Info
@@ -81,7 +101,16 @@ func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fil
 		resDuration   string
 	)
 	if !me.NoProbe {
-		ffInfo, probeErr := me.ffmpegProbe(entryFilePath)
+		var probeErr error
+		if me.scanner != nil {
+			var ok bool
+			ffInfo, ok, probeErr = me.cachedFFmpegProbe(entryFilePath)
+			if !ok {
+				me.scanner.want(entryFilePath, fileInfo)
+			}
+		} else {
+			ffInfo, probeErr = me.ffmpegProbe(entryFilePath)
+		}
 		switch probeErr {
 		case nil:
 			if ffInfo != nil {
@@ -113,14 +142,14 @@ func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fil
 	}()
 	item := upnpav.Item{
 		Object: obj,
-		// Capacity: 1 for raw, 1 for icon, plus transcodes.
-		Res: make([]upnpav.Resource, 0, 2+len(transcodes)),
+		// Capacity: 1 for raw, 1 for icon, 1 for subtitles, plus transcodes.
+		Res: make([]upnpav.Resource, 0, 3+len(transcodes)),
 	}
-	item.Res = append(item.Res, upnpav.Resource{
+	native := upnpav.Resource{
 		URL: (&url.URL{
 			Scheme: "http",
 			Host:   host,
-			Path:   resPath,
+			Path:   me.pathPrefix + resPath,
 			RawQuery: url.Values{
 				"path": {cdsObject.Path},
 			}.Encode(),
@@ -132,18 +161,35 @@ func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fil
 		Duration:   resDuration,
 		Size:       uint64(fileInfo.Size()),
 		Resolution: resolution,
-	})
+	}
+	// The native resource is first if the client plays it, as clients tend
+	// to pick the first they can.
+	nativePlayed := profile.plays(mimeType, "")
+	if nativePlayed {
+		item.Res = append(item.Res, native)
+	}
 	if mimeType.IsVideo() {
-		if !me.NoTranscode {
-			item.Res = append(item.Res, transcodeResources(host, cdsObject.Path, resolution, resDuration)...)
+		if !me.Settings().NoTranscode && !cdsObject.root.NoTranscode {
+			item.Res = append(item.Res, me.transcodeResources(host, cdsObject.Path, resolution, resDuration, profile)...)
 		}
 	}
+	if !nativePlayed && !profile.refuses(mimeType) {
+		// The renderer might play it anyway.
+		item.Res = append(item.Res, native)
+	}
+	if len(item.Res) == 0 {
+		// There's nothing the client can play.
+		return
+	}
+	if mimeType.IsVideo() && profile.subtitles() != "" {
+		me.addSubtitles(&item, cdsObject, host, profile.subtitles())
+	}
 	if mimeType.IsVideo() || mimeType.IsImage() {
 		item.Res = append(item.Res, upnpav.Resource{
 			URL: (&url.URL{
 				Scheme: "http",
 				Host:   host,
-				Path:   iconPath,
+				Path:   me.pathPrefix + iconPath,
 				RawQuery: url.Values{
 					"path": {cdsObject.Path},
 					"c":    {"jpeg"},
@@ -156,20 +202,42 @@ func (me *contentDirectoryService) cdsObjectToUpnpavObject(cdsObject object, fil
 	return
 }
 
-// Returns all the upnpav objects in a directory.
-func (me *contentDirectoryService) readContainer(o object, host, userAgent string) (ret []interface{}, err error) {
+// Returns all the upnpav objects in a directory, or the items of a playlist,
+// that the access rule allows.
+func (me *contentDirectoryService) readContainer(o object, host string, profile *Profile, access *AccessRule) (ret []interface{}, err error) {
+	if o.root == nil {
+		objs, fis := me.rootObjects()
+		for i, child := range objs {
+			obj, err := me.cdsObjectToUpnpavObject(child, fis[i], host, profile, access)
+			if err != nil {
+				log.Printf("error with %s: %s", child.FilePath(), err)
+				continue
+			}
+			if obj != nil {
+				ret = append(ret, obj)
+			}
+		}
+		if me.aggregator != nil && access.allowsAggregated() {
+			ret = append(ret, me.aggregator.containers()...)
+		}
+		return
+	}
+	if playlist.Format(o.Path) != "" {
+		if fi, err := os.Stat(o.FilePath()); err == nil && isPlaylist(fi) {
+			return me.readPlaylist(o, host, profile, access)
+		}
+	}
 	sfis := sortableFileInfoSlice{
-		// TODO(anacrolix): Dig up why this special cast was added.
-		FoldersLast: strings.Contains(userAgent, `AwoX/1.1`),
+		FoldersLast: profile.foldersLast(),
 	}
-	sfis.fileInfoSlice, err = o.readDir()
+	sfis.fileInfoSlice, err = me.readDir(o)
 	if err != nil {
 		return
 	}
 	sort.Sort(sfis)
 	for _, fi := range sfis.fileInfoSlice {
-		child := object{path.Join(o.Path, fi.Name()), me.RootObjectPath}
-		obj, err := me.cdsObjectToUpnpavObject(child, fi, host, userAgent)
+		child := object{path.Join(o.Path, fi.Name()), o.root}
+		obj, err := me.cdsObjectToUpnpavObject(child, fi, host, profile, access)
 		if err != nil {
 			log.Printf("error with %s: %s", child.FilePath(), err)
 			continue
@@ -178,15 +246,82 @@ func (me *contentDirectoryService) readContainer(o object, host, userAgent strin
 			ret = append(ret, obj)
 		}
 	}
+	if o.IsRoot() && me.aggregator != nil && access.allowsAggregated() {
+		ret = append(ret, me.aggregator.containers()...)
+	}
 	return
 }
 
-type browse struct {
-	ObjectID       string
-	BrowseFlag     string
-	Filter         string
-	StartingIndex  int
-	RequestedCount int
+// Returns the objects below a directory whose titles contain q, ignoring
+// case, in the order they'd be browsed to. Directories on the way that can't
+// be read, or that the access rule doesn't allow, are skipped.
+func (me *contentDirectoryService) searchContainer(o object, q, host string, profile *Profile, access *AccessRule) (ret []interface{}, err error) {
+	q = strings.ToLower(q)
+	// The directories being searched, to avoid looping through symlinks.
+	var ancestors []os.FileInfo
+	var walk func(o object, dir os.FileInfo) error
+	// Adds the object if its name matches, and searches it if it's a
+	// directory.
+	visit := func(child object, fi os.FileInfo, name string) {
+		if strings.Contains(strings.ToLower(name), q) {
+			obj, err := me.cdsObjectToUpnpavObject(child, fi, host, profile, access)
+			if err != nil {
+				log.Printf("error with %s: %s", child.FilePath(), err)
+			} else if obj != nil {
+				ret = append(ret, obj)
+			}
+		}
+		if !fi.IsDir() {
+			return
+		}
+		if ignored, err := me.ignoreObject(child, true); err != nil || ignored || !access.allows(child, true) {
+			return
+		}
+		if err := walk(child, fi); err != nil {
+			log.Printf("error searching %s: %s", child.FilePath(), err)
+		}
+	}
+	walk = func(o object, dir os.FileInfo) error {
+		for _, a := range ancestors {
+			if os.SameFile(a, dir) {
+				return nil
+			}
+		}
+		ancestors = append(ancestors, dir)
+		defer func() {
+			ancestors = ancestors[:len(ancestors)-1]
+		}()
+		sfis := sortableFileInfoSlice{
+			FoldersLast: profile.foldersLast(),
+		}
+		var err error
+		sfis.fileInfoSlice, err = me.readDir(o)
+		if err != nil {
+			return err
+		}
+		sort.Sort(sfis)
+		for _, fi := range sfis.fileInfoSlice {
+			visit(object{path.Join(o.Path, fi.Name()), o.root}, fi, fi.Name())
+		}
+		return nil
+	}
+	if o.root == nil {
+		objs, fis := me.rootObjects()
+		for i, child := range objs {
+			visit(child, fis[i], child.root.Name)
+		}
+		return
+	}
+	fi, err := os.Stat(o.FilePath())
+	if err != nil {
+		return
+	}
+	if !fi.IsDir() {
+		err = fmt.Errorf("%s: %w", o.Path, errNotContainer)
+		return
+	}
+	err = walk(o, fi)
+	return
 }
 
 // ContentDirectory object from ObjectID.
@@ -203,104 +338,231 @@ func (me *contentDirectoryService) objectFromID(id string) (o object, err error)
 		err = fmt.Errorf("bad ObjectID %v", o.Path)
 		return
 	}
-	o.RootObjectPath = me.RootObjectPath
-	return
+	return me.Settings().object(o.Path)
 }
 
-func (me *contentDirectoryService) Handle(action string, argsXML []byte, r *http.Request) (map[string]string, error) {
-	host := r.Host
-	userAgent := r.UserAgent()
-	switch action {
-	case "GetSystemUpdateID":
-		return map[string]string{
-			"Id": me.updateIDString(),
-		}, nil
-	case "GetSortCapabilities":
-		return map[string]string{
-			"SortCaps": "dc:title",
-		}, nil
-	case "Browse":
-		var browse browse
-		if err := xml.Unmarshal([]byte(argsXML), &browse); err != nil {
-			return nil, err
-		}
-		obj, err := me.objectFromID(browse.ObjectID)
-		if err != nil {
-			return nil, upnp.Errorf(upnpav.NoSuchObjectErrorCode, err.Error())
+func (me *contentDirectoryService) Handle(action string, argsXML []byte, r *http.Request) ([]upnp.ArgValue, error) {
+	return me.def.Handle(action, argsXML, r)
+}
+
+func (me *contentDirectoryService) getSystemUpdateID(upnp.Args, *http.Request) (upnp.Args, error) {
+	return upnp.Args{
+		"Id": me.updateIDString(),
+	}, nil
+}
+
+func (me *contentDirectoryService) getSortCapabilities(upnp.Args, *http.Request) (upnp.Args, error) {
+	return upnp.Args{
+		"SortCaps": strings.Join(sortCapabilities, ","),
+	}, nil
+}
+
+// The properties objects can be sorted by.
+var sortCapabilities = []string{"dc:title", "dc:date", "upnp:class", "upnp:album", "upnp:originalTrackNumber"}
+
+// Returns the object properties of a Container or Item.
+func upnpavObject(obj interface{}) upnpav.Object {
+	switch o := obj.(type) {
+	case upnpav.Container:
+		return o.Object
+	case upnpav.Item:
+		return o.Object
+	}
+	return upnpav.Object{}
+}
+
+// Compares a property of two objects, returning -1, 0 or 1.
+func compareProperty(prop string, a, b upnpav.Object) int {
+	switch prop {
+	case "dc:title":
+		return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	case "dc:date":
+		return strings.Compare(a.Date, b.Date)
+	case "upnp:class":
+		return strings.Compare(a.Class, b.Class)
+	case "upnp:album":
+		return strings.Compare(a.Album, b.Album)
+	case "upnp:originalTrackNumber":
+		switch {
+		case a.OriginalTrackNumber < b.OriginalTrackNumber:
+			return -1
+		case a.OriginalTrackNumber > b.OriginalTrackNumber:
+			return 1
 		}
-		switch browse.BrowseFlag {
-		case "BrowseDirectChildren":
-			objs, err := me.readContainer(obj, host, userAgent)
-			if err != nil {
-				return nil, upnp.Errorf(upnpav.NoSuchObjectErrorCode, err.Error())
-			}
-			totalMatches := len(objs)
-			objs = objs[func() (low int) {
-				low = browse.StartingIndex
-				if low > len(objs) {
-					low = len(objs)
-				}
-				return
-			}():]
-			if browse.RequestedCount != 0 && int(browse.RequestedCount) < len(objs) {
-				objs = objs[:browse.RequestedCount]
-			}
-			result, err := xml.Marshal(objs)
-			if err != nil {
-				return nil, err
-			}
-			return map[string]string{
-				"TotalMatches":   fmt.Sprint(totalMatches),
-				"NumberReturned": fmt.Sprint(len(objs)),
-				"Result":         didl_lite(string(result)),
-				"UpdateID":       me.updateIDString(),
-			}, nil
-		case "BrowseMetadata":
-			fileInfo, err := os.Stat(obj.FilePath())
-			if err != nil {
-				if os.IsNotExist(err) {
-					return nil, &upnp.Error{
-						Code: upnpav.NoSuchObjectErrorCode,
-						Desc: err.Error(),
-					}
-				}
-				return nil, err
-			}
-			upnp, err := me.cdsObjectToUpnpavObject(obj, fileInfo, host, userAgent)
-			if err != nil {
-				return nil, err
+	}
+	return 0
+}
+
+// Sorts objects by SortCriteria, a list of properties from
+// sortCapabilities, each prefixed by + or - for the direction, as in
+// ContentDirectory:1 section 2.5.8. Objects stay in their order where the
+// criteria don't separate them, or if there aren't any.
+func sortObjects(objs []interface{}, criteria string) error {
+	type key struct {
+		prop string
+		desc bool
+	}
+	var keys []key
+	for _, c := range strings.Split(criteria, ",") {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		k := key{prop: c}
+		switch c[0] {
+		case '-':
+			k = key{c[1:], true}
+		case '+':
+			k.prop = c[1:]
+		}
+		supported := false
+		for _, sc := range sortCapabilities {
+			supported = supported || sc == k.prop
+		}
+		if !supported {
+			return upnp.Errorf(upnpav.InvalidSortCriteriaErrorCode, "can't sort by %q", k.prop)
+		}
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.SliceStable(objs, func(i, j int) bool {
+		a, b := upnpavObject(objs[i]), upnpavObject(objs[j])
+		for _, k := range keys {
+			c := compareProperty(k.prop, a, b)
+			if k.desc {
+				c = -c
 			}
-			buf, err := xml.Marshal(upnp)
-			if err != nil {
-				return nil, err
+			if c != 0 {
+				return c < 0
 			}
-			return map[string]string{
-				"TotalMatches":   "1",
-				"NumberReturned": "1",
-				"Result":         didl_lite(func() string { return string(buf) }()),
-				"UpdateID":       me.updateIDString(),
-			}, nil
-		default:
-			return nil, upnp.Errorf(upnp.ArgumentValueInvalidErrorCode, "unhandled browse flag: %v", browse.BrowseFlag)
 		}
-	case "GetSearchCapabilities":
-		return map[string]string{
-			"SearchCaps": "",
-		}, nil
+		return false
+	})
+	return nil
+}
+
+func (me *contentDirectoryService) getSearchCapabilities(upnp.Args, *http.Request) (upnp.Args, error) {
+	return upnp.Args{
+		"SearchCaps": "",
+	}, nil
+}
+
+func (me *contentDirectoryService) browse(in upnp.Args, r *http.Request) (upnp.Args, error) {
+	// Clients that browse are often renderers, whose sinks decide the
+	// resources offered.
+	me.wantRendererSinks(newClient(r).ip)
+	objs, total, updateID, err := me.browseObjects(r, in["ObjectID"], in["BrowseFlag"], in["SortCriteria"], uint(in.Uint("StartingIndex")), uint(in.Uint("RequestedCount")))
+	if err != nil {
+		return nil, err
+	}
+	result, n, err := me.profile(r).browseResult(objs)
+	if err != nil {
+		return nil, err
+	}
+	return upnp.Args{
+		"TotalMatches":   fmt.Sprint(total),
+		"NumberReturned": fmt.Sprint(n),
+		"Result":         string(result),
+		"UpdateID":       updateID,
+	}, nil
+}
+
+// Returns count objects from start, or all of them from start if count is
+// zero.
+func page(objs []interface{}, start, count uint) []interface{} {
+	if start > uint(len(objs)) {
+		start = uint(len(objs))
+	}
+	objs = objs[start:]
+	if count != 0 && count < uint(len(objs)) {
+		objs = objs[:count]
+	}
+	return objs
+}
+
+// Returns the object with the ObjectID, or a page of its sorted children, as
+// for the Browse action, for clients reaching the server at r.Host. total is
+// the number of objects before paging. Errors are *upnp.Error where the
+// client is at fault.
+func (me *contentDirectoryService) browseObjects(r *http.Request, id, flag, sortCriteria string, start, count uint) (objs []interface{}, total int, updateID string, err error) {
+	access := me.accessRule(r)
+	if udn, rid, ok := parseRemoteObjectID(id); ok && me.aggregator != nil {
+		if !access.allowsAggregated() {
+			err = upnp.Errorf(upnpav.NoSuchObjectErrorCode, "no such object %q", id)
+			return
+		}
+		// Aggregated servers sort as they please.
+		return me.aggregator.browseObjects(r, udn, rid, flag, start, count)
+	}
+	if listID, ok := parsePlaylistEntryID(id); ok {
+		return me.browsePlaylistEntry(r, listID, id, flag, access)
+	}
+	host := r.Host
+	profile := me.profile(r)
+	updateID = me.updateIDString()
+	obj, err := me.objectFromID(id)
+	if err == nil && !access.allows(obj, true) {
+		// It's not known to be a container, but those it could lead to
+		// are allowed.
+		err = fmt.Errorf("no such object %q", id)
+	}
+	if err != nil {
+		err = upnp.Errorf(upnpav.NoSuchObjectErrorCode, "%s", err)
+		return
+	}
+	switch flag {
+	case "BrowseDirectChildren":
+		objs, err = me.readContainer(obj, host, profile, access)
+		if err != nil {
+			err = upnp.Errorf(upnpav.NoSuchObjectErrorCode, "%s", err)
+			return
+		}
+		if err = sortObjects(objs, sortCriteria); err != nil {
+			return
+		}
+		total = len(objs)
+		objs = page(objs, start, profile.browseCount(count))
 	default:
-		return nil, upnp.InvalidActionError
+		// BrowseMetadata, the only other allowed value.
+		if obj.root == nil {
+			objs, total = []interface{}{me.rootsContainer()}, 1
+			return
+		}
+		var fileInfo os.FileInfo
+		fileInfo, err = os.Stat(obj.FilePath())
+		if err != nil {
+			if os.IsNotExist(err) {
+				err = upnp.Errorf(upnpav.NoSuchObjectErrorCode, "%s", err)
+			}
+			return
+		}
+		var upnpObj interface{}
+		upnpObj, err = me.cdsObjectToUpnpavObject(obj, fileInfo, host, profile, access)
+		if err != nil {
+			return
+		}
+		if upnpObj == nil {
+			// It's ignored.
+			err = upnp.Errorf(upnpav.NoSuchObjectErrorCode, "no such object %q", id)
+			return
+		}
+		objs, total = []interface{}{upnpObj}, 1
 	}
+	return
 }
 
 // Represents a ContentDirectory object.
 type object struct {
-	Path           string // The cleaned, absolute path for the object relative to the server.
-	RootObjectPath string
+	Path string // The cleaned, absolute path for the object relative to the server.
+	// The root the object is below. It's nil for the container of the Roots.
+	root *rootMount
 }
 
 // Returns the number of children this object has, such as for a container.
 func (cds *contentDirectoryService) objectChildCount(me object) int {
-	objs, err := cds.readContainer(me, "", "")
+	objs, err := cds.readContainer(me, "", nil, nil)
 	if err != nil {
 		log.Printf("error reading container: %s", err)
 	}
@@ -311,9 +573,13 @@ func (cds *contentDirectoryService) objectHasChildren(obj object) bool {
 	return cds.objectChildCount(obj) != 0
 }
 
-// Returns the actual local filesystem path for the object.
+// Returns the actual local filesystem path for the object. It's always
+// below the object's root.
 func (o *object) FilePath() string {
-	return filepath.Join(o.RootObjectPath, filepath.FromSlash(o.Path))
+	if o.root == nil {
+		return ""
+	}
+	return safeFilePath(o.root.Path, strings.TrimPrefix(o.Path, o.root.objectPath))
 }
 
 // Returns the ObjectID for the object. This is used in various ContentDirectory actions.
@@ -14,16 +14,18 @@ import (
 	"net/http/pprof"
 	"net/url"
 	"os"
-	"os/exec"
 	"os/user"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/anacrolix/dms/dlna"
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/rrcache"
 	"github.com/anacrolix/dms/soap"
-	"github.com/anacrolix/dms/ssdp"
 	"github.com/anacrolix/dms/transcode"
 	"github.com/anacrolix/dms/upnp"
 	"github.com/anacrolix/dms/upnpav"
@@ -59,6 +61,10 @@ var transcodes = map[string]transcodeSpec{
 	"chromecast": {mimeType: "video/mp4", Transcode: transcode.ChromecastTranscode},
 }
 
+// The transcodes in order of preference, where a profile doesn't give one.
+// MPEG-PS for DVD players is the last resort.
+var transcodeOrder = []string{"chromecast", "vp8", "t"}
+
 func makeDeviceUuid(unique string) string {
 	h := md5.New()
 	if _, err := io.WriteString(h, unique); err != nil {
@@ -82,15 +88,19 @@ var services = []*service{
 			ServiceId:   "urn:upnp-org:serviceId:ContentDirectory",
 			EventSubURL: contentDirectoryEventSubURL,
 		},
-		SCPD: contentDirectoryServiceDescription,
+		SCPD: mustMarshalSCPD(new(contentDirectoryService).serviceDef()),
 	},
-	// {
-	// 	Service: upnp.Service{
-	// 		ServiceType: "urn:schemas-upnp-org:service:ConnectionManager:3",
-	// 		ServiceId:   "urn:upnp-org:serviceId:ConnectionManager",
-	// 	},
-	// 	SCPD: connectionManagerServiceDesc,
-	// },
+}
+
+func mustMarshalSCPD(def *upnp.ServiceDef) string {
+	if err := def.Check(); err != nil {
+		panic(err)
+	}
+	b, err := def.MarshalSCPD()
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
 }
 
 // The control URL for every service is the same. We're able to infer the desired service from the request headers.
@@ -141,70 +151,6 @@ func (me *Server) serveHTTP() error {
 	}
 }
 
-// An interface with these flags should be valid for SSDP.
-const ssdpInterfaceFlags = net.FlagUp | net.FlagMulticast
-
-func (me *Server) doSSDP() {
-	active := 0
-	stopped := make(chan struct{})
-	for _, if_ := range me.Interfaces {
-		active++
-		go func(if_ net.Interface) {
-			defer func() {
-				stopped <- struct{}{}
-			}()
-			me.ssdpInterface(if_)
-		}(if_)
-	}
-	for active > 0 {
-		<-stopped
-		active--
-	}
-}
-
-// Run SSDP server on an interface.
-func (me *Server) ssdpInterface(if_ net.Interface) {
-	s := ssdp.Server{
-		Interface: if_,
-		Devices:   devices(),
-		Services:  serviceTypes(),
-		Location: func(ip net.IP) string {
-			return me.location(ip)
-		},
-		Server:         serverField,
-		UUID:           me.rootDeviceUUID,
-		NotifyInterval: me.NotifyInterval,
-	}
-	if err := s.Init(); err != nil {
-		if if_.Flags&ssdpInterfaceFlags != ssdpInterfaceFlags {
-			// Didn't expect it to work anyway.
-			return
-		}
-		if strings.Contains(err.Error(), "listen") {
-			// OSX has a lot of dud interfaces. Failure to create a socket on
-			// the interface are what we're expecting if the interface is no
-			// good.
-			return
-		}
-		log.Printf("error creating ssdp server on %s: %s", if_.Name, err)
-		return
-	}
-	defer s.Close()
-	log.Println("started SSDP on", if_.Name)
-	stopped := make(chan struct{})
-	go func() {
-		defer close(stopped)
-		if err := s.Serve(); err != nil {
-			log.Printf("%q: %q\n", if_.Name, err)
-		}
-	}()
-	select {
-	case <-me.closed:
-		// Returning will close the server.
-	case <-stopped:
-	}
-}
-
 var (
 	startTime time.Time
 )
@@ -215,14 +161,35 @@ type Icon struct {
 	io.ReadSeeker
 }
 
+// A file of the web UI.
+type UIFile struct {
+	ModTime time.Time
+	Content []byte
+}
+
 type Server struct {
-	HTTPConn       net.Listener
-	FriendlyName   string
+	HTTPConn net.Listener
+	// FriendlyName, RootObjectPath, Roots, NoTranscode, IgnoreHidden,
+	// IgnoreUnreadable and AccessRules can be changed with Apply once the
+	// server is serving. The server reads them through Settings.
+	FriendlyName string
+	// Restricts SSDP to the interfaces with these names. All interfaces are
+	// used if it's nil. Interfaces are monitored, so those named needn't be
+	// up yet.
 	Interfaces     []net.Interface
 	httpServeMux   *http.ServeMux
 	RootObjectPath string
+	// Directories served as top-level containers, instead of the
+	// RootObjectPath. Object paths start with their names.
+	Roots []Root
+	// Restrict what clients are served. The first rule that matches a
+	// client applies, and clients no rule matches are served everything.
+	AccessRules    []AccessRule
 	rootDescXML    []byte
 	rootDeviceUUID string
+	bootID         uint32
+	configID       uint32
+	deviceState    deviceState
 	FFProbeCache   Cache
 	closed         chan struct{}
 	ssdpStopped    chan struct{}
@@ -234,20 +201,94 @@ type Server struct {
 	// Disable media probing with ffprobe
 	NoProbe bool
 	Icons   []Icon
+	// The web UI's files, by path, such as "/index.html". They're served
+	// from the root, with "/" as "/index.html". Without them, the root is a
+	// form showing the path served.
+	UI map[string]UIFile
 	// Stall event subscription requests until they drop. A workaround for
-	// some bad clients.
+	// some bad clients, that Profiles can apply to only some.
 	StallEventSubscribe bool
+	// Serve the http URLs in playlists as items, which clients fetch
+	// directly, rather than only the entries that are served files.
+	PlaylistURLs bool
+	// Profiles for clients with quirks, tried before the built-in ones. A
+	// profile replaces the built-in one of the same name.
+	Profiles []Profile
 	// Time interval between SSPD announces
 	NotifyInterval time.Duration
 	// Ignore hidden files and directories
 	IgnoreHidden bool
 	// Ingnore unreadable files and directories
 	IgnoreUnreadable bool
+	// Probe media in the background instead of during Browse. Browse then
+	// only uses cached probe results, which are kept in memory if there's no
+	// FFProbeCache.
+	BackgroundScan bool
+	// Time between background scans of the root path. Zero scans only at
+	// startup, and when the root path is changed.
+	ScanInterval time.Duration
+	// Number of concurrent probes made by the background scanner.
+	ProbeWorkers int
+	// Time allowed for each probe made by the background scanner.
+	ProbeTimeout time.Duration
+	scanner      *scanner
+	// Overrides the device UUID, normally generated once and kept in the
+	// state file. Has the form "uuid:...".
+	DeviceUUID string
+	// File that keeps the device UUID, BOOTID and CONFIGID across restarts.
+	// Without it the UUID is derived from the FriendlyName.
+	StatePath string
+	// Don't use SSDP over IPv6.
+	NoIPv6 bool
+	// Don't advertise the server, as when it only serves files being cast.
+	NoSSDP bool
+	// The network SSDP runs on, the system's if nil.
+	ssdpNetwork ssdpNetwork
+	// Re-export the content of the other MediaServers on the network, each
+	// as a container in the root, with their resources proxied.
+	Aggregate   bool
+	aggregator  *aggregator
+	inited      bool
+	thumbnails  *rrcache.Cache[thumbnailKey, []byte]
+	dirListings *rrcache.Cache[string, dirListing]
+	renderersMu sync.Mutex
+	// Renderers found by the API, and their play queues, by UDN.
+	renderers map[string]*dmc.Renderer
+	queues    map[string]*playQueue
+	// What the renderers at clients' addresses can play.
+	sinks *sinkCache
+	// Enables the admin page, settings API and renderer API, with HTTP basic
+	// authentication against this password.
+	AdminPassword string
+	// Called by Apply with new settings before they take effect, such as to
+	// save them. They aren't applied if it returns an error.
+	SaveSettings func(Settings) error
+	// Guards the settings, and the descriptions that depend on them.
+	settingsMu sync.RWMutex
+	// Serializes Apply.
+	applyMu sync.Mutex
+	// Tells SSDP that the CONFIGID changed.
+	reconfigureSSDP chan struct{}
+	// Changes when the content does, such as when the settings do.
+	systemUpdateID uint32
+	// More media servers to run in this process. Each is a device with its
+	// own Name, FriendlyName, DeviceUUID, settings, AdminPassword and
+	// SaveSettings, served below /virtual/<Name> on this server's HTTPConn.
+	// They're advertised by this server's SSDP, and share its probe cache,
+	// caches and background scanner. Their other fields are ignored, and
+	// they're closed with this server.
+	VirtualServers []*Server
+	// Names a virtual server in its URLs. Its device UUID is derived from
+	// this and its parent's, unless DeviceUUID is set.
+	Name string
+	// Prepended to the paths of the URLs the server gives out, "" except
+	// for virtual servers.
+	pathPrefix string
 }
 
 // UPnP SOAP service.
 type UPnPService interface {
-	Handle(action string, argsXML []byte, r *http.Request) (respArgs map[string]string, err error)
+	Handle(action string, argsXML []byte, r *http.Request) (respArgs []upnp.ArgValue, err error)
 	Subscribe(callback []*url.URL, timeoutSeconds int) (sid string, actualTimeout int, err error)
 	Unsubscribe(sid string) error
 }
@@ -267,7 +308,7 @@ func (dummyFFProbeCache) Get(interface{}) (interface{}, bool) {
 
 // Public definition so that external modules can persist cache contents.
 type FfprobeCacheItem struct {
-	Key   ffmpegInfoCacheKey
+	Key   FfprobeCacheKey
 	Value *ffprobe.Info
 }
 
@@ -283,11 +324,15 @@ func itemExtra(item *upnpav.Object, info *ffprobe.Info) {
 			}
 			switch strings.ToLower(key) {
 			case "tag:artist":
-				setIfUnset(&item.Artist)
+				if len(item.Artist) == 0 {
+					item.Artist = []upnpav.Person{{Name: val.(string)}}
+				}
 			case "tag:album":
 				setIfUnset(&item.Album)
 			case "tag:genre":
-				setIfUnset(&item.Genre)
+				if len(item.Genre) == 0 {
+					item.Genre = []string{val.(string)}
+				}
 			}
 		}
 	}
@@ -297,14 +342,24 @@ func itemExtra(item *upnpav.Object, info *ffprobe.Info) {
 	}
 }
 
-type ffmpegInfoCacheKey struct {
-	Path    string
+// Identifies a probe result. A file's results are invalidated when it's
+// modified.
+type FfprobeCacheKey struct {
+	// Absolute path of the probed file.
+	Path string
+	// Modification time of the file in nanoseconds since the epoch.
 	ModTime int64
 }
 
-func transcodeResources(host, path, resolution, duration string) (ret []upnpav.Resource) {
+// Returns the resources for the transcodes of a video the client's profile
+// wants, and can play, in order of preference.
+func (srv *Server) transcodeResources(host, path, resolution, duration string, profile *Profile) (ret []upnpav.Resource) {
 	ret = make([]upnpav.Resource, 0, len(transcodes))
-	for k, v := range transcodes {
+	for _, k := range profile.transcodes() {
+		v := transcodes[k]
+		if !profile.plays(mimeType(v.mimeType), v.DLNAProfileName) {
+			continue
+		}
 		ret = append(ret, upnpav.Resource{
 			ProtocolInfo: fmt.Sprintf("http-get:*:%s:%s", v.mimeType, dlna.ContentFeatures{
 				SupportTimeSeek: true,
@@ -314,7 +369,7 @@ func transcodeResources(host, path, resolution, duration string) (ret []upnpav.R
 			URL: (&url.URL{
 				Scheme: "http",
 				Host:   host,
-				Path:   resPath,
+				Path:   srv.pathPrefix + resPath,
 				RawQuery: url.Values{
 					"path":      {path},
 					"transcode": {k},
@@ -501,19 +556,16 @@ func handleSCPDs(mux *http.ServeMux) {
 }
 
 // Marshal SOAP response arguments into a response XML snippet.
-func marshalSOAPResponse(sa upnp.SoapAction, args map[string]string) []byte {
+func marshalSOAPResponse(sa upnp.SoapAction, args []upnp.ArgValue) []byte {
 	soapArgs := make([]soap.Arg, 0, len(args))
-	for argName, value := range args {
-		soapArgs = append(soapArgs, soap.Arg{
-			XMLName: xml.Name{Local: argName},
-			Value:   value,
-		})
+	for _, arg := range args {
+		soapArgs = append(soapArgs, soap.NewArg(arg.Name, arg.Value))
 	}
-	return []byte(fmt.Sprintf(`<u:%[1]sResponse xmlns:u="%[2]s">%[3]s</u:%[1]sResponse>`, sa.Action, sa.ServiceURN.String(), xmlMarshalOrPanic(soapArgs)))
+	return soap.MarshalAction(sa.ServiceURN.String(), sa.Action+"Response", soapArgs)
 }
 
 // Handle a SOAP request and return the response arguments or UPnP error.
-func (me *Server) soapActionResponse(sa upnp.SoapAction, actionRequestXML []byte, r *http.Request) (map[string]string, error) {
+func (me *Server) soapActionResponse(sa upnp.SoapAction, actionRequestXML []byte, r *http.Request) ([]upnp.ArgValue, error) {
 	service, ok := me.services[sa.Type]
 	if !ok {
 		// TODO: What's the invalid service error?!
@@ -530,8 +582,8 @@ func (me *Server) serviceControlHandler(w http.ResponseWriter, r *http.Request)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	var env soap.Envelope
-	if err := xml.NewDecoder(r.Body).Decode(&env); err != nil {
+	body, err := soap.ReadEnvelope(r.Body)
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -541,16 +593,15 @@ func (me *Server) serviceControlHandler(w http.ResponseWriter, r *http.Request)
 	w.Header().Set("Ext", "")
 	w.Header().Set("Server", serverField)
 	soapRespXML, code := func() ([]byte, int) {
-		respArgs, err := me.soapActionResponse(soapAction, env.Body.Action, r)
+		respArgs, err := me.soapActionResponse(soapAction, body.Action, r)
 		if err != nil {
 			upnpErr := upnp.ConvertError(err)
-			return xmlMarshalOrPanic(soap.NewFault("UPnPError", upnpErr)), 500
+			return soap.MarshalUPnPFault(upnpErr.Code, upnpErr.Desc), 500
 		}
 		return marshalSOAPResponse(soapAction, respArgs), 200
 	}()
-	bodyStr := fmt.Sprintf(`<?xml version="1.0" encoding="utf-8" standalone="yes"?><s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" s:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/"><s:Body>%s</s:Body></s:Envelope>`, soapRespXML)
 	w.WriteHeader(code)
-	if _, err := w.Write([]byte(bodyStr)); err != nil {
+	if _, err := w.Write(soap.MarshalEnvelope(soapRespXML)); err != nil {
 		log.Print(err)
 	}
 }
@@ -559,19 +610,34 @@ func safeFilePath(root, given string) string {
 	return filepath.Join(root, filepath.FromSlash(path.Clean("/" + given))[1:])
 }
 
-func (s *Server) filePath(_path string) string {
-	return safeFilePath(s.RootObjectPath, _path)
+// Returns the file of the object at the given object path, which is always
+// below the object's root.
+func (s *Server) filePath(_path string) (o object, filePath string, err error) {
+	o, err = s.Settings().object(path.Clean("/" + _path))
+	if err == nil && o.root == nil {
+		err = fmt.Errorf("%s: %w", o.Path, errNotMedia)
+	}
+	if err != nil {
+		return
+	}
+	return o, o.FilePath(), nil
 }
 
 func (me *Server) serveIcon(w http.ResponseWriter, r *http.Request) {
-	filePath := me.filePath(r.URL.Query().Get("path"))
+	o, filePath, err := me.filePath(r.URL.Query().Get("path"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if !me.accessRule(r).allows(o, false) {
+		http.Error(w, "no such object", http.StatusNotFound)
+		return
+	}
 	c := r.URL.Query().Get("c")
 	if c == "" {
 		c = "png"
 	}
-	cmd := exec.Command("ffmpegthumbnailer", "-i", filePath, "-o", "/dev/stdout", "-c"+c)
-	// cmd.Stderr = os.Stderr
-	body, err := cmd.Output()
+	body, err := me.thumbnail(filePath, c)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -587,7 +653,7 @@ func (server *Server) contentDirectoryInitialEvent(urls []*url.URL, sid string)
 					XMLName: xml.Name{
 						Local: "SystemUpdateID",
 					},
-					Value: "0",
+					Value: server.updateIDString(),
 				},
 			},
 			// upnp.Property{
@@ -641,7 +707,7 @@ func (server *Server) contentDirectoryInitialEvent(urls []*url.URL, sid string)
 var eventingLogger = log.New(ioutil.Discard, "", 0)
 
 func (server *Server) contentDirectoryEventSubHandler(w http.ResponseWriter, r *http.Request) {
-	if server.StallEventSubscribe {
+	if server.StallEventSubscribe || server.profile(r).stallEventSubscribe() {
 		// I have an LG TV that doesn't like my eventing implementation.
 		// Returning unimplemented (501?) errors, results in repeat subscribe
 		// attempts which hits some kind of error count limit on the TV
@@ -653,7 +719,8 @@ func (server *Server) contentDirectoryEventSubHandler(w http.ResponseWriter, r *
 		//
 		// I've not found a reliable way to identify this TV, since it and
 		// others don't seem to include any client-identifying headers on
-		// SUBSCRIBE requests.
+		// SUBSCRIBE requests. A profile with its address can stall it
+		// alone.
 		//
 		// TODO: Get eventing to work with the problematic TV.
 		t := time.Now()
@@ -690,32 +757,33 @@ func (server *Server) contentDirectoryEventSubHandler(w http.ResponseWriter, r *
 }
 
 func (server *Server) initMux(mux *http.ServeMux) {
-	mux.HandleFunc("/", func(resp http.ResponseWriter, req *http.Request) {
-		resp.Header().Set("content-type", "text/html")
-		err := rootTmpl.Execute(resp, struct {
-			Readonly bool
-			Path     string
-		}{
-			true,
-			server.RootObjectPath,
-		})
-		if err != nil {
-			log.Println(err)
-		}
-	})
+	mux.HandleFunc("/", server.serveRoot)
 	mux.HandleFunc(contentDirectoryEventSubURL, server.contentDirectoryEventSubHandler)
 	mux.HandleFunc(iconPath, server.serveIcon)
 	mux.HandleFunc(resPath, func(w http.ResponseWriter, r *http.Request) {
-		filePath := server.filePath(r.URL.Query().Get("path"))
-		if ignored, err := server.IgnorePath(filePath); err != nil {
+		o, filePath, err := server.filePath(r.URL.Query().Get("path"))
+		if err != nil {
+			http.Error(w, "no such object", http.StatusNotFound)
+			return
+		}
+		if ignored, err := server.ignoreObject(o, false); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
-		} else if ignored {
+		} else if ignored || !server.accessRule(r).allows(o, false) {
 			http.Error(w, "no such object", http.StatusNotFound)
 			return
 		}
+		if r.URL.Query().Get("subtitles") != "" {
+			server.serveSubtitles(w, r, filePath)
+			return
+		}
 		k := r.URL.Query().Get("transcode")
 		if k == "" {
+			if server.profile(r).subtitles() == "sec" && r.Header.Get("getcaptionInfo.sec") == "1" {
+				if u := server.subtitlesURL(o, r.Host); u != "" {
+					w.Header().Set("CaptionInfo.sec", u)
+				}
+			}
 			mimeType, err := MimeTypeByPath(filePath)
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -725,7 +793,7 @@ func (server *Server) initMux(mux *http.ServeMux) {
 			http.ServeFile(w, r, filePath)
 			return
 		}
-		if server.NoTranscode {
+		if server.Settings().NoTranscode || o.root.NoTranscode {
 			http.Error(w, "transcodes disabled", http.StatusNotFound)
 			return
 		}
@@ -737,14 +805,34 @@ func (server *Server) initMux(mux *http.ServeMux) {
 		server.serveDLNATranscode(w, r, filePath, spec, k)
 	})
 	mux.HandleFunc(rootDescPath, func(w http.ResponseWriter, r *http.Request) {
+		server.settingsMu.RLock()
+		desc := server.rootDescXML
+		server.settingsMu.RUnlock()
 		w.Header().Set("content-type", `text/xml; charset="utf-8"`)
-		w.Header().Set("content-length", fmt.Sprint(len(server.rootDescXML)))
+		w.Header().Set("content-length", fmt.Sprint(len(desc)))
 		w.Header().Set("server", serverField)
-		w.Write(server.rootDescXML)
+		w.Write(desc)
 	})
 	handleSCPDs(mux)
 	mux.HandleFunc(serviceControlURL, server.serviceControlHandler)
+	mux.HandleFunc(objectsPath+"/", server.serveObject)
+	mux.HandleFunc(searchPath, server.serveSearch)
+	mux.HandleFunc(queueEventPath, server.serveQueueEvent)
+	if server.AdminPassword != "" {
+		mux.HandleFunc(adminPath, server.adminHandler(server.serveAdmin))
+		mux.HandleFunc(settingsPath, server.adminHandler(server.serveSettings))
+		// Anyone who can use the renderer API can play on the network's
+		// renderers.
+		mux.HandleFunc(renderersPath, server.adminHandler(server.serveRenderers))
+		mux.HandleFunc(renderersPath+"/", server.adminHandler(server.serveRenderer))
+	}
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	if server.scanner != nil {
+		mux.HandleFunc(scanStatusPath, server.scanner.serveStatus)
+	}
+	if server.aggregator != nil {
+		mux.HandleFunc(proxyPath, server.aggregator.serveProxy)
+	}
 	for i, di := range server.Icons {
 		mux.HandleFunc(fmt.Sprintf("%s/%d", deviceIconPath, i), func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", di.Mimetype)
@@ -759,17 +847,26 @@ func (s *Server) initServices() (err error) {
 		return
 	}
 	s.services = map[string]UPnPService{
-		urn.Type: &contentDirectoryService{
-			Server: s,
-		},
+		urn.Type: newContentDirectoryService(s),
 	}
 	return
 }
 
-func (srv *Server) Serve() (err error) {
+// Init prepares the server to serve, so that it can be used, such as to
+// Cast, as soon as Serve is called. Serve calls it if it hasn't been.
+func (srv *Server) Init() (err error) {
 	if err = srv.initServices(); err != nil {
 		return
 	}
+	if err = checkProfiles(srv.Profiles); err != nil {
+		return
+	}
+	if err = checkAccessRules(srv.AccessRules); err != nil {
+		return
+	}
+	if err = checkContent(srv.Roots, &srv.RootObjectPath); err != nil {
+		return
+	}
 	srv.closed = make(chan struct{})
 	if srv.FriendlyName == "" {
 		srv.FriendlyName = getDefaultFriendlyName()
@@ -780,28 +877,91 @@ func (srv *Server) Serve() (err error) {
 			return
 		}
 	}
-	if srv.Interfaces == nil {
-		ifs, err := net.Interfaces()
-		if err != nil {
-			log.Print(err)
+	if srv.FFProbeCache == nil {
+		if srv.BackgroundScan && !srv.NoProbe {
+			// Browse only sees the scanner's results through the cache.
+			srv.FFProbeCache = newMemoryFFProbeCache()
+		} else {
+			srv.FFProbeCache = dummyFFProbeCache{}
 		}
-		var tmp []net.Interface
-		for _, if_ := range ifs {
-			if if_.Flags&net.FlagUp == 0 || if_.MTU <= 0 {
-				continue
-			}
-			tmp = append(tmp, if_)
+	}
+	srv.initCaches()
+	srv.httpServeMux = http.NewServeMux()
+	if err = srv.initDeviceState(); err != nil {
+		return
+	}
+	if _, err = srv.updateRootDesc(); err != nil {
+		return
+	}
+	srv.reconfigureSSDP = make(chan struct{}, 1)
+	// Distinguishes the content of each run.
+	srv.systemUpdateID = uint32(os.Getpid())
+	log.Println("HTTP srv on", srv.HTTPConn.Addr())
+	if addr, ok := srv.HTTPConn.Addr().(*net.TCPAddr); ok && addr.IP.To4() != nil && !srv.NoIPv6 {
+		// An address of ":port" gives a dual-stack listener.
+		log.Printf("HTTP server isn't listening on IPv6, devices found over IPv6 SSDP won't reach it")
+	}
+	if srv.BackgroundScan && !srv.NoProbe {
+		srv.scanner = newScanner(srv)
+	}
+	if srv.Aggregate {
+		srv.aggregator = newAggregator(srv)
+	}
+	srv.initMux(srv.httpServeMux)
+	if err = srv.initVirtualServers(); err != nil {
+		return
+	}
+	// They look at the virtual servers too.
+	if srv.scanner != nil {
+		go srv.scanner.run()
+	}
+	if srv.aggregator != nil {
+		go srv.aggregator.run()
+	}
+	srv.ssdpStopped = make(chan struct{})
+	srv.inited = true
+	return
+}
+
+func (srv *Server) Serve() (err error) {
+	if !srv.inited {
+		if err = srv.Init(); err != nil {
+			return
 		}
-		srv.Interfaces = tmp
 	}
-	if srv.FFProbeCache == nil {
-		srv.FFProbeCache = dummyFFProbeCache{}
+	go func() {
+		if !srv.NoSSDP {
+			srv.doSSDP()
+		}
+		close(srv.ssdpStopped)
+	}()
+	return srv.serveHTTP()
+}
+
+// Marshals the root device description, and assigns its CONFIGID. The
+// settings must be locked once the server is serving. Returns true if the
+// CONFIGID changed.
+func (srv *Server) updateRootDesc() (configChanged bool, err error) {
+	// The CONFIGID is part of the description, so it's left out of the
+	// description that's hashed to assign it.
+	srv.configID = 0
+	srv.rootDescXML, err = srv.marshalRootDesc()
+	if err != nil {
+		return
 	}
-	srv.httpServeMux = http.NewServeMux()
-	srv.rootDeviceUUID = makeDeviceUuid(srv.FriendlyName)
-	srv.rootDescXML, err = xml.MarshalIndent(
+	if configChanged, err = srv.updateConfigID(); err != nil {
+		return
+	}
+	srv.rootDescXML, err = srv.marshalRootDesc()
+	return
+}
+
+// Returns the root device description XML.
+func (srv *Server) marshalRootDesc() ([]byte, error) {
+	b, err := xml.MarshalIndent(
 		upnp.DeviceDesc{
-			SpecVersion: upnp.SpecVersion{Major: 1, Minor: 0},
+			ConfigID:    srv.configID,
+			SpecVersion: upnp.SpecVersion{Major: 1, Minor: 1},
 			Device: upnp.Device{
 				DeviceType:   rootDeviceType,
 				FriendlyName: srv.FriendlyName,
@@ -810,7 +970,11 @@ func (srv *Server) Serve() (err error) {
 				UDN:          srv.rootDeviceUUID,
 				ServiceList: func() (ss []upnp.Service) {
 					for _, s := range services {
-						ss = append(ss, s.Service)
+						s := s.Service
+						s.SCPDURL = srv.pathPrefix + s.SCPDURL
+						s.ControlURL = srv.pathPrefix + s.ControlURL
+						s.EventSubURL = srv.pathPrefix + s.EventSubURL
+						ss = append(ss, s)
 					}
 					return
 				}(),
@@ -821,7 +985,7 @@ func (srv *Server) Serve() (err error) {
 							Width:    di.Width,
 							Depth:    di.Depth,
 							Mimetype: di.Mimetype,
-							URL:      fmt.Sprintf("%s/%d", deviceIconPath, i),
+							URL:      fmt.Sprintf("%s%s/%d", srv.pathPrefix, deviceIconPath, i),
 						})
 					}
 					return
@@ -830,50 +994,37 @@ func (srv *Server) Serve() (err error) {
 		},
 		" ", "  ")
 	if err != nil {
-		return
+		return nil, err
 	}
-	srv.rootDescXML = append([]byte(`<?xml version="1.0"?>`), srv.rootDescXML...)
-	log.Println("HTTP srv on", srv.HTTPConn.Addr())
-	srv.initMux(srv.httpServeMux)
-	srv.ssdpStopped = make(chan struct{})
-	go func() {
-		srv.doSSDP()
-		close(srv.ssdpStopped)
-	}()
-	return srv.serveHTTP()
+	return append([]byte(`<?xml version="1.0"?>`), b...), nil
 }
 
 func (srv *Server) Close() (err error) {
 	close(srv.closed)
 	err = srv.HTTPConn.Close()
 	<-srv.ssdpStopped
+	if srv.scanner != nil {
+		<-srv.scanner.stopped
+	}
+	if srv.aggregator != nil {
+		<-srv.aggregator.stopped
+	}
 	return
 }
 
-func didl_lite(chardata string) string {
-	return `<DIDL-Lite` +
-		` xmlns:dc="http://purl.org/dc/elements/1.1/"` +
-		` xmlns:upnp="urn:schemas-upnp-org:metadata-1-0/upnp/"` +
-		` xmlns="urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/"` +
-		` xmlns:dlna="urn:schemas-dlna-org:metadata-1-0/">` +
-		chardata +
-		`</DIDL-Lite>`
-}
-
+// Returns the root description URL to advertise for an address. IPv6
+// addresses are bracketed. Link-local zones are left out, they're
+// meaningless to the receiver.
 func (me *Server) location(ip net.IP) string {
 	url := url.URL{
 		Scheme: "http",
-		Host: (&net.TCPAddr{
-			IP:   ip,
-			Port: me.httpPort(),
-		}).String(),
-		Path: rootDescPath,
+		Host:   net.JoinHostPort(ip.String(), strconv.Itoa(me.httpPort())),
+		Path:   me.pathPrefix + rootDescPath,
 	}
 	return url.String()
 }
 
-// Can return nil info with nil err if an earlier Probe gave an error.
-func (srv *Server) ffmpegProbe(path string) (info *ffprobe.Info, err error) {
+func ffmpegProbeCacheKey(path string) (key FfprobeCacheKey, err error) {
 	// We don't want relative paths in the cache.
 	path, err = filepath.Abs(path)
 	if err != nil {
@@ -883,10 +1034,19 @@ func (srv *Server) ffmpegProbe(path string) (info *ffprobe.Info, err error) {
 	if err != nil {
 		return
 	}
-	key := ffmpegInfoCacheKey{path, fi.ModTime().UnixNano()}
+	key = FfprobeCacheKey{path, fi.ModTime().UnixNano()}
+	return
+}
+
+// Can return nil info with nil err if an earlier Probe gave an error.
+func (srv *Server) ffmpegProbe(path string) (info *ffprobe.Info, err error) {
+	key, err := ffmpegProbeCacheKey(path)
+	if err != nil {
+		return
+	}
 	value, ok := srv.FFProbeCache.Get(key)
 	if !ok {
-		info, err = ffprobe.Run(path)
+		info, err = ffprobe.Run(key.Path)
 		err = suppressFFmpegProbeDataErrors(err)
 		srv.FFProbeCache.Set(key, info)
 		return
@@ -895,12 +1055,32 @@ func (srv *Server) ffmpegProbe(path string) (info *ffprobe.Info, err error) {
 	return
 }
 
+// Returns the cached probe result without running ffprobe. ok is false if
+// the file hasn't been probed since it was last modified.
+func (srv *Server) cachedFFmpegProbe(path string) (info *ffprobe.Info, ok bool, err error) {
+	key, err := ffmpegProbeCacheKey(path)
+	if err != nil {
+		return
+	}
+	value, ok := srv.FFProbeCache.Get(key)
+	if ok {
+		info = value.(*ffprobe.Info)
+	}
+	return
+}
+
 // IgnorePath detects if a file/directory should be ignored.
 func (server *Server) IgnorePath(path string) (bool, error) {
+	settings := server.Settings()
+	return ignorePath(path, settings.IgnoreHidden, settings.IgnoreUnreadable)
+}
+
+// Whether the path is hidden or unreadable, if either is to be ignored.
+func ignorePath(path string, hidden, unreadable bool) (bool, error) {
 	if !filepath.IsAbs(path) {
 		return false, fmt.Errorf("Path must be absolute: %s", path)
 	}
-	if server.IgnoreHidden {
+	if hidden {
 		if hidden, err := isHiddenPath(path); err != nil {
 			return false, err
 		} else if hidden {
@@ -908,7 +1088,7 @@ func (server *Server) IgnorePath(path string) (bool, error) {
 			return true, nil
 		}
 	}
-	if server.IgnoreUnreadable {
+	if unreadable {
 		if readable, err := isReadablePath(path); err != nil {
 			return false, err
 		} else if !readable {
@@ -2,6 +2,8 @@ package dms
 
 import (
 	"bytes"
+	"fmt"
+	"net"
 	"net/http"
 	"runtime"
 	"testing"
@@ -64,3 +66,23 @@ func TestResponse(t *testing.T) {
 	resp.Write(&buf)
 	t.Logf("%q", buf.String())
 }
+
+func TestLocation(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	s := &Server{HTTPConn: l}
+	port := s.httpPort()
+	for ip, expected := range map[string]string{
+		"192.168.1.2": "http://192.168.1.2:%d/rootDesc.xml",
+		"fe80::1":     "http://[fe80::1]:%d/rootDesc.xml",
+		"2001:db8::1": "http://[2001:db8::1]:%d/rootDesc.xml",
+	} {
+		actual := s.location(net.ParseIP(ip))
+		if actual != fmt.Sprintf(expected, port) {
+			t.Errorf("expected %q for %s but got %q", fmt.Sprintf(expected, port), ip, actual)
+		}
+	}
+}
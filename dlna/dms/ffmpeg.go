@@ -1,9 +1,13 @@
 package dms
 
 import (
+	"context"
+	"encoding/json"
 	"os/exec"
 	"runtime"
 	"syscall"
+
+	"github.com/anacrolix/ffprobe"
 )
 
 func suppressFFmpegProbeDataErrors(_err error) (err error) {
@@ -29,3 +33,20 @@ func suppressFFmpegProbeDataErrors(_err error) (err error) {
 	}
 	return
 }
+
+// Runs ffprobe on the file, as ffprobe.Run does, but kills it if the context
+// is done first.
+func ffprobeContext(ctx context.Context, path string) (info *ffprobe.Info, err error) {
+	exe, err := exec.LookPath("ffprobe")
+	if err != nil {
+		err = ffprobe.ExeNotFound
+		return
+	}
+	cmd := exec.CommandContext(ctx, exe, "-loglevel", "error", "-show_format", "-show_streams", "-of", "json", path)
+	out, err := cmd.Output()
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(out, &info)
+	return
+}
@@ -1,7 +1,10 @@
 package dms
 
 import (
+	"bytes"
 	"html/template"
+	"log"
+	"net/http"
 )
 
 var (
@@ -10,12 +13,65 @@ var (
 
 func init() {
 	rootTmpl = template.Must(template.New("root").Parse(
-		`<form method="post">
-			Path: <input type="text"
+		`<form method="post" action="{{.Action}}">
+			<p>Name: <input type="text"
+				name="friendlyName"
+				{{if .Readonly}} readonly="readonly"{{end}}
+				value="{{.FriendlyName}}"
+			/></p>
+			<p>Path: <input type="text"
 				name="path"
 				{{if .Readonly}} readonly="readonly"{{end}}
-				value="{{.Path}}"
-			/>
+				value="{{.RootObjectPath}}"
+			/></p>
+			{{range .Roots}}<p>Root {{.Name}}: {{.Path}}</p>
+			{{end}}			<p><label><input type="checkbox" name="noTranscode"
+				{{if .NoTranscode}} checked="checked"{{end}}
+				{{if .Readonly}} disabled="disabled"{{end}}
+			/> Disable transcoding</label></p>
+			<p><label><input type="checkbox" name="ignoreHidden"
+				{{if .IgnoreHidden}} checked="checked"{{end}}
+				{{if .Readonly}} disabled="disabled"{{end}}
+			/> Ignore hidden files</label></p>
+			<p><label><input type="checkbox" name="ignoreUnreadable"
+				{{if .IgnoreUnreadable}} checked="checked"{{end}}
+				{{if .Readonly}} disabled="disabled"{{end}}
+			/> Ignore unreadable files</label></p>
 			<input type="submit" value="Update"{{if .Readonly}} disabled="disabled"{{end}}/>
 		</form>`))
 }
+
+// Renders the settings form. Only the admin page can change them.
+func (server *Server) writeSettingsForm(resp http.ResponseWriter, readonly bool) {
+	resp.Header().Set("content-type", "text/html")
+	err := rootTmpl.Execute(resp, struct {
+		Readonly bool
+		Action   string
+		Settings
+	}{
+		readonly,
+		server.pathPrefix + adminPath,
+		server.Settings(),
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Serves the web UI, or the read-only settings form if there isn't one.
+func (server *Server) serveRoot(resp http.ResponseWriter, req *http.Request) {
+	if len(server.UI) == 0 {
+		server.writeSettingsForm(resp, true)
+		return
+	}
+	name := req.URL.Path
+	if name == "/" {
+		name = "/index.html"
+	}
+	f, ok := server.UI[name]
+	if !ok {
+		http.NotFound(resp, req)
+		return
+	}
+	http.ServeContent(resp, req, name, f.ModTime, bytes.NewReader(f.Content))
+}
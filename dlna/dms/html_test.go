@@ -0,0 +1,34 @@
+package dms
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServeRoot(t *testing.T) {
+	get := func(srv *Server, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		srv.serveRoot(w, httptest.NewRequest("GET", path, nil))
+		return w
+	}
+	srv := &Server{RootObjectPath: "/media"}
+	if w := get(srv, "/"); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `value="/media"`) {
+		t.Fatalf("no path form: %d %q", w.Code, w.Body)
+	}
+	srv.UI = map[string]UIFile{
+		"/index.html": {time.Now(), []byte("<!DOCTYPE html>")},
+		"/app.js":     {time.Now(), []byte("route()")},
+	}
+	if w := get(srv, "/"); w.Body.String() != "<!DOCTYPE html>" || !strings.HasPrefix(w.Header().Get("Content-Type"), "text/html") {
+		t.Fatalf("wrong index: %q %q", w.Header(), w.Body)
+	}
+	if w := get(srv, "/app.js"); w.Body.String() != "route()" || !strings.Contains(w.Header().Get("Content-Type"), "javascript") {
+		t.Fatalf("wrong script: %q %q", w.Header(), w.Body)
+	}
+	if w := get(srv, "/nothing"); w.Code != http.StatusNotFound {
+		t.Fatal(w.Code)
+	}
+}
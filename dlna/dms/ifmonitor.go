@@ -0,0 +1,45 @@
+package dms
+
+import (
+	"log"
+	"time"
+)
+
+const (
+	// Interval between checks for interface changes when they can't be
+	// watched.
+	ifPollInterval = 5 * time.Second
+	// Interval between checks when changes are being watched, in case a
+	// notification is missed.
+	ifWatchPollInterval = time.Minute
+)
+
+// Calls changed whenever network interfaces or their addresses may have
+// changed, until closed is closed. Changes are watched where the platform
+// supports it, and polled for otherwise.
+func watchInterfaces(closed <-chan struct{}, changed func()) {
+	monitorInterfaces(closed, changed, watchInterfaceChanges, time.After)
+}
+
+// Does the work of watchInterfaces, with the platform's watcher and the
+// timer given.
+func monitorInterfaces(closed <-chan struct{}, changed func(), watch func(closed <-chan struct{}, changed func()) (<-chan struct{}, error), after func(time.Duration) <-chan time.Time) {
+	interval := ifWatchPollInterval
+	stopped, err := watch(closed, changed)
+	if err != nil {
+		log.Printf("can't watch for interface changes, polling instead: %s", err)
+		interval = ifPollInterval
+	}
+	for {
+		select {
+		case <-after(interval):
+			changed()
+		case <-stopped:
+			// Watching failed, fall back to polling.
+			stopped = nil
+			interval = ifPollInterval
+		case <-closed:
+			return
+		}
+	}
+}
@@ -0,0 +1,71 @@
+//+build linux
+
+package dms
+
+import (
+	"log"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// Watches for link and address changes with a netlink route socket. The
+// returned channel is closed if watching fails.
+func watchInterfaceChanges(closed <-chan struct{}, changed func()) (<-chan struct{}, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_ROUTE)
+	if err != nil {
+		return nil, err
+	}
+	err = unix.Bind(fd, &unix.SockaddrNetlink{
+		Family: unix.AF_NETLINK,
+		Groups: unix.RTMGRP_LINK | unix.RTMGRP_IPV4_IFADDR | unix.RTMGRP_IPV6_IFADDR,
+	})
+	if err == nil {
+		// Wake up periodically to check whether we've been closed.
+		tv := unix.NsecToTimeval(int64(time.Second))
+		err = unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv)
+	}
+	if err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		defer unix.Close(fd)
+		buf := make([]byte, 1<<16)
+		for {
+			select {
+			case <-closed:
+				return
+			default:
+			}
+			n, _, err := unix.Recvfrom(fd, buf, 0)
+			switch err {
+			case nil:
+			case unix.EAGAIN, unix.EINTR:
+				continue
+			case unix.ENOBUFS:
+				// Messages were dropped, so something changed.
+				changed()
+				continue
+			default:
+				log.Printf("error reading netlink socket: %s", err)
+				return
+			}
+			msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+			if err != nil {
+				log.Printf("error parsing netlink message: %s", err)
+				continue
+			}
+			for _, m := range msgs {
+				switch m.Header.Type {
+				case unix.RTM_NEWLINK, unix.RTM_DELLINK, unix.RTM_NEWADDR, unix.RTM_DELADDR:
+					changed()
+				}
+			}
+		}
+	}()
+	return stopped, nil
+}
@@ -0,0 +1,10 @@
+//+build !linux
+
+package dms
+
+import "errors"
+
+func watchInterfaceChanges(closed <-chan struct{}, changed func()) (stopped <-chan struct{}, err error) {
+	err = errors.New("not supported on this platform")
+	return
+}
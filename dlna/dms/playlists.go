@@ -0,0 +1,208 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/playlist"
+	"github.com/anacrolix/dms/upnp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+// Whether the file is a playlist, which is served as a container of its
+// entries.
+func isPlaylist(fi os.FileInfo) bool {
+	return fi.Mode().IsRegular() && playlist.Format(fi.Name()) != ""
+}
+
+// Returns the upnpav items for the entries of the playlist object that are
+// media in the tree, and with PlaylistURLs, those that are http URLs.
+// Entries that are missing, or that aren't allowed, are skipped. Each item's
+// ObjectID is that of its entry, and those in the tree refer to the objects
+// for their files.
+func (me *contentDirectoryService) readPlaylist(list object, host string, profile *Profile, access *AccessRule) (ret []interface{}, err error) {
+	f, err := os.Open(list.FilePath())
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	entries, err := playlist.Parse(f, playlist.Format(list.Path))
+	if err != nil {
+		err = fmt.Errorf("%s: %s", list.FilePath(), err)
+		return
+	}
+	for i, e := range entries {
+		if u, err := url.Parse(e.Location); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+			if me.PlaylistURLs {
+				if item, ok := playlistURLItem(list, i, u, e.Title, access); ok {
+					ret = append(ret, item)
+				}
+			}
+			continue
+		}
+		child, fi, err := me.playlistEntryObject(list, e.Location)
+		if err != nil {
+			continue
+		}
+		obj, err := me.cdsObjectToUpnpavObject(child, fi, host, profile, access)
+		if err != nil {
+			log.Printf("error with %s: %s", child.FilePath(), err)
+			continue
+		}
+		// Containers, such as other playlists, aren't followed.
+		if item, ok := obj.(upnpav.Item); ok {
+			item.RefID = item.ID
+			item.ID = playlistEntryID(list, i)
+			item.ParentID = list.ID()
+			ret = append(ret, item)
+		}
+	}
+	return
+}
+
+// Returns the object for the path in a playlist entry. Relative paths are
+// relative to the playlist's directory. It must be below one of the roots.
+func (srv *Server) playlistEntryObject(list object, loc string) (o object, fi os.FileInfo, err error) {
+	if u, err := url.Parse(loc); err == nil && u.Scheme == "file" {
+		loc = u.Path
+	}
+	// Playlists made on Windows use backslashes.
+	p := filepath.FromSlash(strings.Replace(loc, `\`, "/", -1))
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(filepath.Dir(list.FilePath()), p)
+	}
+	o, ok := srv.Settings().fileObject(filepath.Clean(p))
+	if !ok {
+		err = fmt.Errorf("%s isn't served", p)
+		return
+	}
+	fi, err = os.Stat(o.FilePath())
+	return
+}
+
+// Returns the ObjectID of a playlist's entry at the index.
+func playlistEntryID(list object, index int) string {
+	return list.ID() + "$" + strconv.Itoa(index)
+}
+
+// Returns the playlist's ObjectID from the ObjectID of one of its entries.
+// The ObjectIDs of files are escaped, so they can't contain '$'.
+func parsePlaylistEntryID(id string) (listID string, ok bool) {
+	i := strings.LastIndexByte(id, '$')
+	if i < 0 {
+		return
+	}
+	if _, err := strconv.ParseUint(id[i+1:], 10, 0); err != nil {
+		return
+	}
+	return id[:i], true
+}
+
+// Handles a Browse of a playlist entry, which is found by reading its
+// playlist. Entries have no children.
+func (me *contentDirectoryService) browsePlaylistEntry(r *http.Request, listID, id, flag string, access *AccessRule) (objs []interface{}, total int, updateID string, err error) {
+	updateID = me.updateIDString()
+	list, err := me.objectFromID(listID)
+	if err == nil && access.allows(list, true) {
+		var entries []interface{}
+		entries, err = me.readContainer(list, r.Host, me.profile(r), access)
+		for _, obj := range entries {
+			if item, ok := obj.(upnpav.Item); ok && item.ID == id {
+				if flag == dmc.BrowseMetadata {
+					objs, total = []interface{}{item}, 1
+				}
+				return
+			}
+		}
+	}
+	err = upnp.Errorf(upnpav.NoSuchObjectErrorCode, "no such object %q", id)
+	return
+}
+
+// Returns an item for an http URL in a playlist, which clients fetch
+// directly.
+func playlistURLItem(list object, index int, u *url.URL, title string, access *AccessRule) (item upnpav.Item, ok bool) {
+	mt := mimeTypeByBaseName(path.Base(u.Path))
+	if !mt.IsMedia() {
+		// Most likely a radio stream.
+		mt = ""
+	}
+	mediaType := "audio"
+	if mt != "" {
+		mediaType = mt.Type()
+	}
+	if access.hidesMediaType(mediaType) {
+		return
+	}
+	if title == "" {
+		title = path.Base(u.Path)
+	}
+	protocolInfo := "http-get:*:*:*"
+	if mt != "" {
+		protocolInfo = fmt.Sprintf("http-get:*:%s:*", mt)
+	}
+	item = upnpav.Item{
+		Object: upnpav.Object{
+			ID:         playlistEntryID(list, index),
+			ParentID:   list.ID(),
+			Restricted: 1,
+			Class:      "object.item." + mediaType + "Item",
+			Title:      title,
+		},
+		Res: []upnpav.Resource{{URL: u.String(), ProtocolInfo: protocolInfo}},
+	}
+	return item, true
+}
+
+// Playlist formats containers can be fetched as, and their content types.
+var playlistContentTypes = map[string]string{
+	"m3u":  "audio/x-mpegurl; charset=utf-8",
+	"xspf": "application/xspf+xml",
+}
+
+// Serves the items of the container with the ID as a playlist in the
+// format, for players such as VLC. Each item's first resource is used.
+func (srv *Server) servePlaylist(w http.ResponseWriter, r *http.Request, id, format string) {
+	cds := &contentDirectoryService{Server: srv}
+	meta, _, _, err := cds.browseObjects(r, id, dmc.BrowseMetadata, "", 0, 0)
+	if err == nil && len(meta) == 0 {
+		err = fmt.Errorf("no such object %q", id)
+	}
+	if err != nil {
+		writeObjectsError(w, err)
+		return
+	}
+	c, ok := meta[0].(upnpav.Container)
+	if !ok {
+		http.Error(w, fmt.Sprintf("%s: %s", id, errNotContainer), http.StatusNotFound)
+		return
+	}
+	objs, _, _, err := cds.browseObjects(r, id, dmc.BrowseDirectChildren, "", 0, 0)
+	if err != nil {
+		writeObjectsError(w, err)
+		return
+	}
+	var entries []playlist.Entry
+	for _, obj := range objs {
+		if item, ok := obj.(upnpav.Item); ok && len(item.Res) != 0 {
+			entries = append(entries, playlist.Entry{Location: item.Res[0].URL, Title: item.Title})
+		}
+	}
+	w.Header().Set("Content-Type", playlistContentTypes[format])
+	if format == "xspf" {
+		err = playlist.WriteXSPF(w, c.Title, entries)
+	} else {
+		err = playlist.WriteM3U(w, entries)
+	}
+	if err != nil {
+		log.Printf("error writing playlist: %s", err)
+	}
+}
@@ -0,0 +1,128 @@
+package dms
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anacrolix/dms/upnp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+func newPlaylistTestServer(t *testing.T) *Server {
+	dir := t.TempDir()
+	writeTestTree(t, dir, map[string]string{
+		"Music/a.mp3": "a",
+		"Music/b.mp3": "b",
+		"Lists/mix.m3u": strings.Join([]string{
+			"#EXTM3U",
+			"../Music/a.mp3",
+			"#EXTINF:-1,Radio",
+			"http://radio/stream",
+			filepath.Join(dir, "Music", "b.mp3"),
+			"/outside/x.mp3",
+			"missing.mp3",
+		}, "\n"),
+		"Lists/old.wpl": `<smil><body><seq><media src="..\Music\b.mp3"/></seq></body></smil>`,
+	})
+	return &Server{RootObjectPath: dir, NoProbe: true, NoTranscode: true}
+}
+
+func TestPlaylistContainers(t *testing.T) {
+	srv := newPlaylistTestServer(t)
+	cds := &contentDirectoryService{Server: srv}
+	browse := func(id string) (ret []interface{}) {
+		objs, _, _, err := cds.browseObjects(httptest.NewRequest("POST", "http://dms/ctl", nil), id, "BrowseDirectChildren", "", 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return objs
+	}
+	ids := func(objs []interface{}) (ret []string) {
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case upnpav.Item:
+				ret = append(ret, o.ID+">"+o.RefID)
+			case upnpav.Container:
+				ret = append(ret, o.ID)
+			}
+		}
+		return
+	}
+	lists := browse("%2FLists")
+	if len(lists) != 2 {
+		t.Fatalf("got %+v", lists)
+	}
+	for _, obj := range lists {
+		if c, ok := obj.(upnpav.Container); !ok || c.Class != "object.container.playlistContainer" {
+			t.Fatalf("not a playlist container: %+v", obj)
+		}
+	}
+	if c := lists[0].(upnpav.Container); c.ID != "%2FLists%2Fmix.m3u" || c.Title != "mix" {
+		t.Fatalf("wrong playlist: %+v", c)
+	}
+	// The entries are the items in the tree, and those outside it or
+	// missing are left out. They're below the playlist, and refer to the
+	// items.
+	if got := strings.Join(ids(browse("%2FLists%2Fmix.m3u")), " "); got != "%2FLists%2Fmix.m3u$0>%2FMusic%2Fa.mp3 %2FLists%2Fmix.m3u$2>%2FMusic%2Fb.mp3" {
+		t.Errorf("got %q", got)
+	}
+	if got := strings.Join(ids(browse("%2FLists%2Fold.wpl")), " "); got != "%2FLists%2Fold.wpl$0>%2FMusic%2Fb.mp3" {
+		t.Errorf("got %q", got)
+	}
+	// Entries can be browsed.
+	r := httptest.NewRequest("POST", "http://dms/ctl", nil)
+	meta, total, _, err := cds.browseObjects(r, "%2FLists%2Fmix.m3u$2", "BrowseMetadata", "", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i, ok := meta[0].(upnpav.Item); total != 1 || !ok || i.ParentID != "%2FLists%2Fmix.m3u" || i.RefID != "%2FMusic%2Fb.mp3" || i.Title != "b.mp3" {
+		t.Errorf("wrong entry: %+v", meta)
+	}
+	if objs, total, _, err := cds.browseObjects(r, "%2FLists%2Fmix.m3u$2", "BrowseDirectChildren", "", 0, 0); err != nil || total != 0 || len(objs) != 0 {
+		t.Errorf("entry has children: %v %+v", err, objs)
+	}
+	for _, id := range []string{"%2FLists%2Fmix.m3u$1", "%2FLists%2Fmissing.m3u$0", "%2FMusic$0"} {
+		if _, _, _, err := cds.browseObjects(r, id, "BrowseMetadata", "", 0, 0); upnp.ConvertError(err).Code != upnpav.NoSuchObjectErrorCode {
+			t.Errorf("%s: got %v", id, err)
+		}
+	}
+	srv.PlaylistURLs = true
+	objs := browse("%2FLists%2Fmix.m3u")
+	if len(objs) != 3 {
+		t.Fatalf("got %+v", objs)
+	}
+	if i := objs[1].(upnpav.Item); i.ID != "%2FLists%2Fmix.m3u$1" || i.Title != "Radio" || i.Class != "object.item.audioItem" || i.ParentID != "%2FLists%2Fmix.m3u" || i.Res[0].URL != "http://radio/stream" {
+		t.Errorf("wrong URL item: %+v", i)
+	}
+}
+
+func TestServePlaylist(t *testing.T) {
+	srv := newPlaylistTestServer(t)
+	get := func(target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		srv.serveObject(w, httptest.NewRequest("GET", "http://dms"+target, nil))
+		return w
+	}
+	w := get(objectsPath + "/%252FMusic/playlist.m3u")
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code)
+	}
+	if got := w.Body.String(); got != "#EXTM3U\n#EXTINF:-1,a.mp3\nhttp://dms/res?path=%2FMusic%2Fa.mp3\n#EXTINF:-1,b.mp3\nhttp://dms/res?path=%2FMusic%2Fb.mp3\n" {
+		t.Fatalf("got %q", got)
+	}
+	w = get(objectsPath + "/%252FLists%252Fold.wpl/playlist.xspf")
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/xspf+xml" {
+		t.Fatal(w.Code, w.Header())
+	}
+	if body := w.Body.String(); !strings.Contains(body, "<title>old</title>") || !strings.Contains(body, "<location>http://dms/res?path=%2FMusic%2Fb.mp3</location>") {
+		t.Fatalf("got %s", body)
+	}
+	for _, target := range []string{"/%252FMusic%252Fa.mp3/playlist.m3u", "/%252Fmissing/playlist.m3u"} {
+		if w := get(objectsPath + target); w.Code != http.StatusNotFound {
+			t.Errorf("%s: got %d", target, w.Code)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package dms
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anacrolix/dms/upnpav"
+)
+
+// A Profile adapts what's served to the clients it matches, such as a model
+// of TV, working around their quirks.
+type Profile struct {
+	// Identifies the profile in logs, and lets a user's profile replace the
+	// built-in one of the same name.
+	Name string `json:"name"`
+	ClientMatch
+	// List folders after files, instead of before.
+	FoldersLast bool `json:"foldersLast,omitempty"`
+	// The most objects returned by a Browse. Clients page through the rest.
+	// Zero doesn't limit them.
+	MaxBrowseCount uint `json:"maxBrowseCount,omitempty"`
+	// The largest DIDL-Lite Result of a Browse, in bytes, for clients with
+	// fixed buffers that the count alone can't protect, as objects vary in
+	// size. Objects are left off the end to fit, and clients page through
+	// the rest. Zero doesn't limit it.
+	MaxBrowseSize uint `json:"maxBrowseSize,omitempty"`
+	// MIME types the clients play, such as "video/mp4", or "video/*" for a
+	// whole type. Files of other types are only offered transcoded, and
+	// hidden if they can't be. Everything is offered if it's empty.
+	MimeTypes []string `json:"mimeTypes,omitempty"`
+	// The transcodes offered, in order of preference, such as "chromecast".
+	// All of them are offered if it's empty.
+	Transcodes []string `json:"transcodes,omitempty"`
+	// Offer no transcodes.
+	NoTranscode bool `json:"noTranscode,omitempty"`
+	// How subtitle files beside videos, with the same name and a .srt
+	// extension, are offered: "res" as a resource of the video, "sec" as
+	// Samsung's CaptionInfoEx, or not at all if it's empty.
+	Subtitles string `json:"subtitles,omitempty"`
+	// Stall event subscriptions, as StallEventSubscribe does for every
+	// client.
+	StallEventSubscribe bool `json:"stallEventSubscribe,omitempty"`
+	// The client's renderer's sink protocol infos, if they're known. They
+	// decide what the client plays when MimeTypes doesn't.
+	sinks []protocolInfo
+}
+
+// Profiles for clients known to need them. Profiles are tried in order, so
+// more specific ones come first.
+var builtinProfiles = []Profile{
+	{
+		Name:        "AwoX",
+		ClientMatch: ClientMatch{UserAgents: []string{"AwoX/1.1"}},
+		FoldersLast: true,
+	},
+	{
+		Name:        "Samsung",
+		ClientMatch: ClientMatch{UserAgents: []string{"SEC_HHP_", "SamsungWiselinkPro"}},
+		Subtitles:   "sec",
+	},
+	{
+		Name:        "LG",
+		ClientMatch: ClientMatch{UserAgents: []string{"LGE_DLNA_SDK"}},
+		Subtitles:   "res",
+	},
+}
+
+// Checks the profiles' criteria parse, and that their settings are known.
+func checkProfiles(profiles []Profile) error {
+	for i := range profiles {
+		p := &profiles[i]
+		if err := p.check(); err != nil {
+			return fmt.Errorf("profile %q: %s", p.Name, err)
+		}
+		for _, k := range p.Transcodes {
+			if _, ok := transcodes[k]; !ok {
+				return fmt.Errorf("profile %q: unknown transcode %q", p.Name, k)
+			}
+		}
+		switch p.Subtitles {
+		case "", "res", "sec":
+		default:
+			return fmt.Errorf("profile %q: unknown subtitle style %q", p.Name, p.Subtitles)
+		}
+	}
+	return nil
+}
+
+// Returns the profile for the request's client, with the sinks of its
+// renderer if they're known. It's nil if there's neither.
+func (srv *Server) profile(r *http.Request) *Profile {
+	c := newClient(r)
+	return srv.matchProfile(c).withSinks(srv.sinks.get(c.ip))
+}
+
+// Returns the first of the Profiles that matches the client, then the first
+// of the built-in profiles not replaced by one of the same name. It's nil if
+// none match.
+func (srv *Server) matchProfile(c client) *Profile {
+	for i := range srv.Profiles {
+		if srv.Profiles[i].matches(c) {
+			return &srv.Profiles[i]
+		}
+	}
+builtins:
+	for i := range builtinProfiles {
+		p := &builtinProfiles[i]
+		for _, o := range srv.Profiles {
+			if o.Name == p.Name {
+				continue builtins
+			}
+		}
+		if p.matches(c) {
+			return p
+		}
+	}
+	return nil
+}
+
+// Returns a copy of the profile with the renderer sinks, or the profile if
+// there are none.
+func (p *Profile) withSinks(sinks []protocolInfo) *Profile {
+	if len(sinks) == 0 {
+		return p
+	}
+	var ret Profile
+	if p != nil {
+		ret = *p
+	}
+	ret.sinks = sinks
+	return &ret
+}
+
+// Whether folders are listed after files.
+func (p *Profile) foldersLast() bool {
+	return p != nil && p.FoldersLast
+}
+
+// Limits a Browse's requested count, where zero is all of them.
+func (p *Profile) browseCount(count uint) uint {
+	if p == nil || p.MaxBrowseCount == 0 || count != 0 && count <= p.MaxBrowseCount {
+		return count
+	}
+	return p.MaxBrowseCount
+}
+
+// Marshals a Browse's objects as DIDL-Lite, leaving those off the end that
+// don't fit in the MaxBrowseSize. n is the number kept. The first object is
+// always kept, so that clients paging through make progress.
+func (p *Profile) browseResult(objs []interface{}) (result []byte, n int, err error) {
+	result, err = upnpav.MarshalDIDLLite(objs...)
+	if err != nil || p == nil || p.MaxBrowseSize == 0 || uint(len(result)) <= p.MaxBrowseSize || len(objs) <= 1 {
+		return result, len(objs), err
+	}
+	// Find the most objects that fit. One always does.
+	lo, hi := 1, len(objs)-1
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		b, err := upnpav.MarshalDIDLLite(objs[:mid]...)
+		if err != nil {
+			return nil, 0, err
+		}
+		if uint(len(b)) <= p.MaxBrowseSize {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	result, err = upnpav.MarshalDIDLLite(objs[:lo]...)
+	return result, lo, err
+}
+
+// Whether the clients play media of the MIME type, and DLNA profile if pn
+// is given. Everything is played if neither the MimeTypes nor the sinks are
+// known.
+func (p *Profile) plays(mt mimeType, pn string) bool {
+	switch {
+	case p == nil:
+		return true
+	case len(p.MimeTypes) != 0:
+		return p.listsMimeType(mt)
+	case len(p.sinks) != 0:
+		for _, pi := range p.sinks {
+			if pi.accepts(mt, pn) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Whether the clients are known not to play the MIME type. A renderer's
+// sinks aren't trusted to be complete, unlike MimeTypes.
+func (p *Profile) refuses(mt mimeType) bool {
+	return p != nil && len(p.MimeTypes) != 0 && !p.listsMimeType(mt)
+}
+
+func (p *Profile) listsMimeType(mt mimeType) bool {
+	for _, t := range p.MimeTypes {
+		if t == string(mt) || strings.HasSuffix(t, "/*") && t[:len(t)-1] == mt.Type()+"/" {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the keys of the transcodes offered, in order of preference.
+func (p *Profile) transcodes() []string {
+	if p != nil && p.NoTranscode {
+		return nil
+	}
+	if p != nil && len(p.Transcodes) != 0 {
+		return p.Transcodes
+	}
+	return transcodeOrder
+}
+
+// Returns how subtitles are offered.
+func (p *Profile) subtitles() string {
+	if p == nil {
+		return ""
+	}
+	return p.Subtitles
+}
+
+// Whether the client's event subscriptions are stalled.
+func (p *Profile) stallEventSubscribe() bool {
+	return p != nil && p.StallEventSubscribe
+}
+
+// Returns the path of the subtitle file beside a video, with the same name
+// and a .srt extension.
+func subtitlesFilePath(filePath string) string {
+	return strings.TrimSuffix(filePath, filepath.Ext(filePath)) + ".srt"
+}
+
+// Returns the URL of the video object's subtitles, or "" if it has none.
+func (srv *Server) subtitlesURL(o object, host string) string {
+	if fi, err := os.Stat(subtitlesFilePath(o.FilePath())); err != nil || !fi.Mode().IsRegular() {
+		return ""
+	}
+	return (&url.URL{
+		Scheme: "http",
+		Host:   host,
+		Path:   srv.pathPrefix + resPath,
+		RawQuery: url.Values{
+			"path":      {o.Path},
+			"subtitles": {"srt"},
+		}.Encode(),
+	}).String()
+}
+
+// Offers the video item's subtitles, if it has any, in the style given.
+func (srv *Server) addSubtitles(item *upnpav.Item, o object, host, style string) {
+	u := srv.subtitlesURL(o, host)
+	if u == "" {
+		return
+	}
+	switch style {
+	case "res":
+		item.Res = append(item.Res, upnpav.Resource{
+			URL:          u,
+			ProtocolInfo: "http-get:*:text/srt:*",
+		})
+	case "sec":
+		item.CaptionInfoEx = append(item.CaptionInfoEx, upnpav.CaptionInfo{URL: u, Type: "srt"})
+	}
+}
+
+// Serves the subtitles of the video at filePath.
+func (srv *Server) serveSubtitles(w http.ResponseWriter, r *http.Request, filePath string) {
+	f, err := os.Open(subtitlesFilePath(filePath))
+	if err != nil {
+		http.Error(w, "no subtitles", http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/srt")
+	http.ServeContent(w, r, "", fi.ModTime(), f)
+}
@@ -0,0 +1,156 @@
+package dms
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anacrolix/dms/upnp"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+func TestProfileSelection(t *testing.T) {
+	srv := &Server{Profiles: []Profile{
+		{Name: "Samsung", ClientMatch: ClientMatch{Addrs: []string{"192.168.1.50"}}},
+		{Name: "Bedroom", ClientMatch: ClientMatch{FriendlyNames: []string{"bedroom"}}},
+	}}
+	profileName := func(userAgent, friendlyName, remoteAddr string) string {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("User-Agent", userAgent)
+		r.Header.Set("FriendlyName.DLNA.ORG", friendlyName)
+		r.RemoteAddr = remoteAddr
+		if p := srv.profile(r); p != nil {
+			return p.Name
+		}
+		return ""
+	}
+	for _, c := range []struct {
+		userAgent, friendlyName, remoteAddr, want string
+	}{
+		{"AwoX/1.1 UPnP/1.0", "", "192.168.1.7:1234", "AwoX"},
+		{"AwoX/1.1 UPnP/1.0", "Bedroom TV", "192.168.1.7:1234", "Bedroom"},
+		// The user's Samsung profile replaces the built-in one.
+		{"SEC_HHP_[TV] Samsung/1.0", "", "192.168.1.7:1234", ""},
+		{"SEC_HHP_[TV] Samsung/1.0", "", "192.168.1.50:1234", "Samsung"},
+		{"VLC", "", "192.168.1.7:1234", ""},
+	} {
+		if got := profileName(c.userAgent, c.friendlyName, c.remoteAddr); got != c.want {
+			t.Errorf("%q %q %s: got profile %q, expected %q", c.userAgent, c.friendlyName, c.remoteAddr, got, c.want)
+		}
+	}
+}
+
+func TestCheckProfiles(t *testing.T) {
+	for _, p := range []Profile{
+		{Name: "a", Transcodes: []string{"nope"}},
+		{Name: "b", Subtitles: "burnt"},
+		{Name: "c", ClientMatch: ClientMatch{Addrs: []string{"1.2.3"}}},
+	} {
+		if err := checkProfiles([]Profile{p}); err == nil {
+			t.Errorf("%+v accepted", p)
+		}
+	}
+	if err := checkProfiles(builtinProfiles); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProfileBrowse(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.mp4", "a.srt", "b.mp3"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	srv := &Server{
+		RootObjectPath: dir,
+		NoProbe:        true,
+		NoTranscode:    true,
+		Profiles: []Profile{{
+			Name:           "TestTV",
+			ClientMatch:    ClientMatch{UserAgents: []string{"TestTV"}},
+			FoldersLast:    true,
+			MaxBrowseCount: 1,
+			MimeTypes:      []string{"video/*"},
+			Subtitles:      "sec",
+		}},
+	}
+	cds := &contentDirectoryService{Server: srv}
+	r := httptest.NewRequest("POST", "http://dms/ctl", nil)
+	r.Header.Set("User-Agent", "TestTV/1.0")
+	objs, total, _, err := cds.browseObjects(r, "0", "BrowseDirectChildren", "", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The audio is hidden, and the folder is last and cut off.
+	if total != 2 || len(objs) != 1 {
+		t.Fatalf("got %d of %d objects: %+v", len(objs), total, objs)
+	}
+	item, ok := objs[0].(upnpav.Item)
+	if !ok || item.ID != "%2Fa.mp4" || len(item.CaptionInfoEx) != 1 {
+		t.Fatalf("wrong item: %+v", objs[0])
+	}
+	mux := http.NewServeMux()
+	srv.initMux(mux)
+	req := httptest.NewRequest("GET", "http://dms/res?path=%2Fa.mp4", nil)
+	req.Header.Set("User-Agent", "TestTV/1.0")
+	req.Header.Set("getcaptionInfo.sec", "1")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if u := w.Header().Get("CaptionInfo.sec"); u != item.CaptionInfoEx[0].URL {
+		t.Fatalf("CaptionInfo.sec %q, expected %q", u, item.CaptionInfoEx[0].URL)
+	}
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", item.CaptionInfoEx[0].URL, nil))
+	if w.Code != http.StatusOK || w.Body.String() != "a.srt" || w.Header().Get("Content-Type") != "text/srt" {
+		t.Fatalf("%d %q %q", w.Code, w.Header().Get("Content-Type"), w.Body)
+	}
+	// Other clients get everything, without subtitles.
+	r.Header.Set("User-Agent", "VLC")
+	if objs, _, _, err = cds.browseObjects(r, "0", "BrowseDirectChildren", "", 0, 0); err != nil || len(objs) != 3 {
+		t.Fatalf("%v %+v", err, objs)
+	}
+	if item := objs[1].(upnpav.Item); len(item.CaptionInfoEx) != 0 || len(item.Res) != 2 {
+		t.Fatalf("wrong item: %+v", item)
+	}
+}
+
+func TestProfileBrowseSize(t *testing.T) {
+	dir := t.TempDir()
+	writeTestTree(t, dir, map[string]string{"a.mp3": "a", "b.mp3": "b", "c.mp3": "c", "d.mp3": "d"})
+	srv := &Server{RootObjectPath: dir, NoProbe: true, NoTranscode: true}
+	cds := &contentDirectoryService{Server: srv}
+	r := httptest.NewRequest("POST", "http://dms/ctl", nil)
+	r.Header.Set("User-Agent", "TestTV/1.0")
+	objs, _, _, err := cds.browseObjects(r, "0", "BrowseDirectChildren", "", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	two, err := upnpav.MarshalDIDLLite(objs[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for max, returned := range map[uint]string{uint(len(two)): "2", uint(len(two)) + 1: "2", 1: "1"} {
+		srv.Profiles = []Profile{{Name: "TestTV", ClientMatch: ClientMatch{UserAgents: []string{"TestTV"}}, MaxBrowseSize: max}}
+		out, err := cds.browse(upnp.Args{"ObjectID": "0", "BrowseFlag": "BrowseDirectChildren"}, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out["NumberReturned"] != returned || out["TotalMatches"] != "4" {
+			t.Errorf("max %d: returned %s of %s", max, out["NumberReturned"], out["TotalMatches"])
+		}
+		if d, err := upnpav.UnmarshalDIDLLite([]byte(out["Result"])); err != nil || fmt.Sprint(len(d.Objects)) != returned {
+			t.Errorf("max %d: %v %+v", max, err, d)
+		}
+		if returned != "1" && uint(len(out["Result"])) > max {
+			t.Errorf("max %d: result is %d bytes", max, len(out["Result"]))
+		}
+	}
+}
@@ -0,0 +1,503 @@
+package dms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+const (
+	queueEventPath = "/evt/queue"
+	// Time between polls of renderers that don't send events.
+	queuePollInterval = 2 * time.Second
+	// Requested length of event subscriptions, which are renewed halfway.
+	queueSubscriptionTimeout = 5 * time.Minute
+)
+
+// Repeat modes.
+const (
+	repeatOff = "off"
+	repeatAll = "all"
+	repeatOne = "one"
+)
+
+var (
+	errNotContainer = errors.New("not a container")
+	errNoMedia      = errors.New("no media in container")
+	errQueueEnd     = errors.New("no more items in queue")
+)
+
+// A play queue for a renderer. If the renderer has SetNextAVTransportURI,
+// it's told the next item as each starts, otherwise the next item is set
+// when it stops at the end of one. Either way the queue follows the
+// renderer through AVTransport events, or by polling if it doesn't send
+// them.
+type playQueue struct {
+	srv *Server
+	r   *dmc.Renderer
+	// The host the renderer fetches media from.
+	host string
+	// Time allowed for each request to the renderer.
+	timeout time.Duration
+
+	mu    sync.Mutex
+	items []upnpav.Item
+	// Indexes of items, in play order.
+	order []int
+	// Index in order of the item playing.
+	pos int
+	// Index in order of the item set with SetNextAVTransportURI, or -1.
+	next    int
+	shuffle bool
+	repeat  string
+	// The renderer lacks SetNextAVTransportURI.
+	noSetNext bool
+	// The item playing has been seen to start, so stopping is its end.
+	started bool
+	// The queue has finished, or was stopped through the API.
+	stopped bool
+	rand    *rand.Rand
+	// The event subscription ID. Guarded by srv.renderersMu, so events
+	// aren't held up by actions in progress.
+	sid string
+
+	events chan map[string]string
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newPlayQueue(srv *Server, r *dmc.Renderer, host string, items []upnpav.Item) *playQueue {
+	q := &playQueue{
+		srv:     srv,
+		r:       r,
+		host:    host,
+		timeout: rendererTimeout,
+		items:   items,
+		next:    -1,
+		repeat:  repeatOff,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		events:  make(chan map[string]string, 16),
+		closed:  make(chan struct{}),
+	}
+	for i := range items {
+		q.order = append(q.order, i)
+	}
+	return q
+}
+
+func (q *playQueue) item(pos int) upnpav.Item {
+	return q.items[q.order[pos]]
+}
+
+func (q *playQueue) url(pos int) string {
+	return q.item(pos).Res[0].URL
+}
+
+// Returns the position of the item to play when the current one ends, or -1
+// at the end of the queue.
+func (q *playQueue) nextPos() int {
+	switch {
+	case q.repeat == repeatOne:
+		return q.pos
+	case q.pos+1 < len(q.order):
+		return q.pos + 1
+	case q.repeat == repeatAll:
+		return 0
+	}
+	return -1
+}
+
+// Plays the item at pos. q.mu must be held.
+func (q *playQueue) play(ctx context.Context, pos int) error {
+	item := q.item(pos)
+	metadata, err := upnpav.MarshalDIDLLite(item)
+	if err != nil {
+		return err
+	}
+	if err := q.r.SetAVTransportURI(ctx, item.Res[0].URL, string(metadata)); err != nil {
+		return err
+	}
+	if err := q.r.Play(ctx); err != nil {
+		return err
+	}
+	q.pos, q.next, q.started, q.stopped = pos, -1, false, false
+	q.setNext(ctx)
+	return nil
+}
+
+// Tells the renderer what to play next, if it can be told. q.mu must be
+// held.
+func (q *playQueue) setNext(ctx context.Context) {
+	if q.noSetNext {
+		return
+	}
+	n := q.nextPos()
+	if n < 0 {
+		return
+	}
+	item := q.item(n)
+	metadata, err := upnpav.MarshalDIDLLite(item)
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	if err := q.r.SetNextAVTransportURI(ctx, item.Res[0].URL, string(metadata)); err != nil {
+		log.Printf("%s: not using SetNextAVTransportURI: %s", q.r.FriendlyName, err)
+		q.noSetNext = true
+		return
+	}
+	q.next = n
+}
+
+// Updates the queue with the renderer's transport state and track URI,
+// either of which may be empty if they're unknown.
+func (q *playQueue) observe(ctx context.Context, state, uri string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.stopped {
+		return
+	}
+	if uri != "" && q.next >= 0 && uri == q.url(q.next) && uri != q.url(q.pos) {
+		// The renderer moved on to the item it was told was next.
+		q.pos, q.next, q.started = q.next, -1, false
+		q.setNext(ctx)
+	}
+	switch state {
+	case dmc.Playing, dmc.PausedPlayback:
+		q.started = true
+	case dmc.Stopped, dmc.NoMediaPresent:
+		if !q.started {
+			return
+		}
+		n := q.nextPos()
+		if n < 0 {
+			q.stopped = true
+			return
+		}
+		if err := q.play(ctx, n); err != nil {
+			log.Printf("%s: error playing next item: %s", q.r.FriendlyName, err)
+			q.stopped = true
+		}
+	}
+}
+
+// Returns a context for a request to the renderer, which times out.
+func (q *playQueue) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), q.timeout)
+}
+
+// Follows the renderer's state until the queue is closed.
+func (q *playQueue) follow() {
+	defer q.close()
+	ctx, cancel := q.context()
+	sid, granted, err := q.r.Subscribe(ctx, "http://"+q.host+q.srv.pathPrefix+queueEventPath, queueSubscriptionTimeout)
+	cancel()
+	var (
+		poll  <-chan time.Time
+		renew <-chan time.Time
+	)
+	startPolling := func() {
+		t := time.NewTicker(queuePollInterval)
+		poll = t.C
+		go func() {
+			<-q.closed
+			t.Stop()
+		}()
+	}
+	if err != nil {
+		log.Printf("%s: polling, as subscribing to events failed: %s", q.r.FriendlyName, err)
+		startPolling()
+	} else {
+		q.srv.renderersMu.Lock()
+		q.sid = sid
+		q.srv.renderersMu.Unlock()
+		renew = time.After(granted / 2)
+	}
+	for {
+		// Each request gets its own timeout, from when it's made.
+		select {
+		case <-q.closed:
+		case <-q.srv.closed:
+		case vars := <-q.events:
+			state, uri := eventState(vars)
+			ctx, cancel := q.context()
+			q.observe(ctx, state, uri)
+			cancel()
+			continue
+		case <-poll:
+			ctx, cancel := q.context()
+			if ti, err := q.r.TransportInfo(ctx); err != nil {
+				log.Printf("%s: %s", q.r.FriendlyName, err)
+			} else if pi, err := q.r.PositionInfo(ctx); err != nil {
+				log.Printf("%s: %s", q.r.FriendlyName, err)
+			} else {
+				q.observe(ctx, ti.State, pi.URI)
+			}
+			cancel()
+			continue
+		case <-renew:
+			ctx, cancel := q.context()
+			if granted, err = q.r.Renew(ctx, sid, queueSubscriptionTimeout); err != nil {
+				log.Printf("%s: polling, as renewing the subscription failed: %s", q.r.FriendlyName, err)
+				renew = nil
+				startPolling()
+			} else {
+				renew = time.After(granted / 2)
+			}
+			cancel()
+			continue
+		}
+		if renew != nil {
+			ctx, cancel := q.context()
+			if err := q.r.Unsubscribe(ctx, sid); err != nil {
+				log.Printf("%s: error unsubscribing: %s", q.r.FriendlyName, err)
+			}
+			cancel()
+		}
+		return
+	}
+}
+
+// Returns the transport state and track URI from the variables of an
+// AVTransport event.
+func eventState(vars map[string]string) (state, uri string) {
+	if vars["LastChange"] == "" {
+		return
+	}
+	lc, err := dmc.ParseLastChange(vars["LastChange"])
+	if err != nil {
+		log.Printf("bad LastChange: %s", err)
+		return
+	}
+	uri = lc["CurrentTrackURI"]
+	if uri == "" {
+		uri = lc["AVTransportURI"]
+	}
+	return lc["TransportState"], uri
+}
+
+// Stops following the renderer.
+func (q *playQueue) close() {
+	q.once.Do(func() {
+		close(q.closed)
+	})
+}
+
+// Skips forward or back by delta items, wrapping around if repeating all.
+func (q *playQueue) skip(ctx context.Context, delta int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	pos := q.pos + delta
+	if q.repeat == repeatAll {
+		pos = (pos%len(q.order) + len(q.order)) % len(q.order)
+	} else if pos < 0 {
+		pos = 0
+	} else if pos >= len(q.order) {
+		return errQueueEnd
+	}
+	return q.play(ctx, pos)
+}
+
+// Orders the items for shuffle or not. Shuffling keeps the current item
+// first, and orders the rest randomly. q.mu must be held.
+func (q *playQueue) reorder(shuffle bool) {
+	q.shuffle = shuffle
+	cur := q.order[q.pos]
+	if !shuffle {
+		for i := range q.order {
+			q.order[i] = i
+		}
+		q.pos = cur
+		return
+	}
+	rest := append(q.order[:q.pos:q.pos], q.order[q.pos+1:]...)
+	q.rand.Shuffle(len(rest), func(i, j int) {
+		rest[i], rest[j] = rest[j], rest[i]
+	})
+	q.order = append([]int{cur}, rest...)
+	q.pos = 0
+}
+
+// Sets shuffle and repeat, and updates what the renderer plays next.
+func (q *playQueue) setMode(ctx context.Context, shuffle bool, repeat string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.repeat = repeat
+	if shuffle != q.shuffle {
+		q.reorder(shuffle)
+	}
+	if !q.stopped {
+		q.next = -1
+		q.setNext(ctx)
+	}
+}
+
+func (q *playQueue) stop(ctx context.Context) error {
+	q.mu.Lock()
+	q.stopped = true
+	q.mu.Unlock()
+	q.close()
+	return q.r.Stop(ctx)
+}
+
+type queueItemJSON struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// Items are in play order.
+type queueJSON struct {
+	Items    []queueItemJSON `json:"items"`
+	Position int             `json:"position"`
+	Shuffle  bool            `json:"shuffle"`
+	Repeat   string          `json:"repeat"`
+	Playing  bool            `json:"playing"`
+	// Whether the renderer is told the next item in advance.
+	Gapless bool `json:"gapless"`
+}
+
+func (q *playQueue) json() (ret queueJSON) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for pos := range q.order {
+		item := q.item(pos)
+		ret.Items = append(ret.Items, queueItemJSON{item.ID, item.Title, item.Res[0].URL})
+	}
+	ret.Position = q.pos
+	ret.Shuffle = q.shuffle
+	ret.Repeat = q.repeat
+	ret.Playing = !q.stopped
+	ret.Gapless = !q.noSetNext
+	return
+}
+
+// Returns the items in a container, as seen by the renderer at host with the
+// profile. Only what the access rule allows is returned.
+func (srv *Server) containerItems(containerID, host string, profile *Profile, access *AccessRule) (ret []upnpav.Item, err error) {
+	cds := &contentDirectoryService{Server: srv}
+	o, err := cds.objectFromID(containerID)
+	if err != nil {
+		return
+	}
+	if !access.allows(o, true) {
+		err = fmt.Errorf("%s: %w", o.Path, errNoSuchObject)
+		return
+	}
+	fi, err := os.Stat(o.FilePath())
+	if err != nil {
+		return
+	}
+	if !fi.IsDir() {
+		err = fmt.Errorf("%s: %w", o.Path, errNotContainer)
+		return
+	}
+	objs, err := cds.readContainer(o, host, profile, access)
+	if err != nil {
+		return
+	}
+	for _, obj := range objs {
+		if item, ok := obj.(upnpav.Item); ok {
+			ret = append(ret, item)
+		}
+	}
+	if len(ret) == 0 {
+		err = fmt.Errorf("%s: %w", o.Path, errNoMedia)
+	}
+	return
+}
+
+// Replaces the renderer's queue with the items in a container that the
+// access rule allows, and starts playing them.
+func (srv *Server) startQueue(ctx context.Context, r *dmc.Renderer, containerID string, shuffle bool, repeat string, access *AccessRule) (*playQueue, error) {
+	host, err := srv.rendererHost(r)
+	if err != nil {
+		return nil, err
+	}
+	items, err := srv.containerItems(containerID, host, srv.rendererProfile(ctx, r), access)
+	if err != nil {
+		return nil, err
+	}
+	q := newPlayQueue(srv, r, host, items)
+	q.mu.Lock()
+	q.repeat = repeat
+	if shuffle {
+		// Shuffled queues start anywhere.
+		q.pos = q.rand.Intn(len(q.order))
+		q.reorder(true)
+	}
+	err = q.play(ctx, q.pos)
+	q.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	srv.setQueue(r.UDN, q)
+	go q.follow()
+	return q, nil
+}
+
+// Sets the renderer's queue, closing any it had. A nil queue removes it.
+func (srv *Server) setQueue(udn string, q *playQueue) {
+	srv.renderersMu.Lock()
+	defer srv.renderersMu.Unlock()
+	if old := srv.queues[udn]; old != nil {
+		old.close()
+	}
+	if q == nil {
+		delete(srv.queues, udn)
+		return
+	}
+	if srv.queues == nil {
+		srv.queues = make(map[string]*playQueue)
+	}
+	srv.queues[udn] = q
+}
+
+func (srv *Server) queue(udn string) *playQueue {
+	srv.renderersMu.Lock()
+	defer srv.renderersMu.Unlock()
+	return srv.queues[udn]
+}
+
+// Receives the AVTransport events of renderers playing queues.
+func (srv *Server) serveQueueEvent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "NOTIFY" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	sid := r.Header.Get("SID")
+	var q *playQueue
+	srv.renderersMu.Lock()
+	for _, q_ := range srv.queues {
+		if q_.sid == sid {
+			q = q_
+		}
+	}
+	srv.renderersMu.Unlock()
+	if q == nil {
+		// As required for unknown subscriptions, by UDA 1.1 section 4.2.
+		http.Error(w, "no such subscription", http.StatusPreconditionFailed)
+		return
+	}
+	vars, err := dmc.ParseEvent(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// The queue acts on the event later, as some renderers won't handle
+	// actions until the event is acknowledged.
+	select {
+	case q.events <- vars:
+	default:
+		log.Printf("%s: dropped event", q.r.FriendlyName)
+	}
+}
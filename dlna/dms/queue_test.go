@@ -0,0 +1,283 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+	"github.com/anacrolix/dms/soap"
+	"github.com/anacrolix/dms/upnpav"
+)
+
+const queueTestRendererDesc = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:MediaRenderer:1</deviceType>
+    <friendlyName>TV</friendlyName>
+    <UDN>uuid:tv</UDN>
+    <serviceList>
+      <service>
+        <serviceType>urn:schemas-upnp-org:service:AVTransport:1</serviceType>
+        <controlURL>/avt</controlURL>
+        <eventSubURL>/evt</eventSubURL>
+      </service>
+    </serviceList>
+  </device>
+</root>`
+
+// Records the AVTransport actions it's sent, as "Action URI", and event
+// subscription requests by method.
+type queueTestRenderer struct {
+	noSetNext bool
+	mu        sync.Mutex
+	calls     []string
+}
+
+func (f *queueTestRenderer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/evt" {
+		f.mu.Lock()
+		f.calls = append(f.calls, r.Method)
+		f.mu.Unlock()
+		w.Header().Set("SID", "uuid:sub")
+		return
+	}
+	if r.URL.Path != "/avt" {
+		w.Write([]byte(queueTestRendererDesc))
+		return
+	}
+	body, _ := soap.ReadEnvelope(r.Body)
+	a, _ := soap.UnmarshalAction(body.Action)
+	name := a.XMLName.Local
+	if name == "SetNextAVTransportURI" && f.noSetNext {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(soap.MarshalEnvelope(soap.MarshalUPnPFault(401, "Invalid Action")))
+		return
+	}
+	uri, _ := a.Arg("CurrentURI")
+	if next, ok := a.Arg("NextURI"); ok {
+		uri = next
+	}
+	f.mu.Lock()
+	f.calls = append(f.calls, strings.TrimSpace(name+" "+uri))
+	f.mu.Unlock()
+	w.Write(soap.MarshalEnvelope(soap.MarshalAction(a.XMLName.Space, name+"Response", nil)))
+}
+
+// Returns the calls since the last.
+func (f *queueTestRenderer) takeCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ret := f.calls
+	f.calls = nil
+	return ret
+}
+
+func newTestQueue(t *testing.T, f *queueTestRenderer, n int) (*playQueue, func()) {
+	s := httptest.NewServer(f)
+	r, err := dmc.NewRenderer(context.Background(), s.URL+"/desc.xml")
+	if err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+	var items []upnpav.Item
+	for i := 0; i < n; i++ {
+		items = append(items, upnpav.Item{
+			Object: upnpav.Object{ID: fmt.Sprint(i), Title: fmt.Sprint(i)},
+			Res:    []upnpav.Resource{{URL: fmt.Sprintf("http://dms/%d", i)}},
+		})
+	}
+	return newPlayQueue(&Server{}, r, "dms", items), s.Close
+}
+
+func checkCalls(t *testing.T, f *queueTestRenderer, expected ...string) {
+	t.Helper()
+	if calls := f.takeCalls(); !reflect.DeepEqual(calls, expected) {
+		t.Errorf("got calls %q, expected %q", calls, expected)
+	}
+}
+
+func TestQueueSetNext(t *testing.T) {
+	f := &queueTestRenderer{}
+	q, close := newTestQueue(t, f, 3)
+	defer close()
+	ctx := context.Background()
+	q.mu.Lock()
+	if err := q.play(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	q.mu.Unlock()
+	checkCalls(t, f, "SetAVTransportURI http://dms/0", "Play", "SetNextAVTransportURI http://dms/1")
+	q.observe(ctx, dmc.Playing, "http://dms/0")
+	checkCalls(t, f)
+	// The renderer moves on by itself, and is told the one after.
+	q.observe(ctx, dmc.Playing, "http://dms/1")
+	checkCalls(t, f, "SetNextAVTransportURI http://dms/2")
+	q.observe(ctx, dmc.Transitioning, "http://dms/2")
+	checkCalls(t, f)
+	if q.pos != 2 {
+		t.Fatalf("at %d", q.pos)
+	}
+	// Stopping at the end of the last item ends the queue.
+	q.observe(ctx, dmc.Playing, "http://dms/2")
+	q.observe(ctx, dmc.Stopped, "http://dms/2")
+	checkCalls(t, f)
+	if q.json().Playing {
+		t.Error("queue still playing")
+	}
+}
+
+func TestQueueWithoutSetNext(t *testing.T) {
+	f := &queueTestRenderer{noSetNext: true}
+	q, close := newTestQueue(t, f, 2)
+	defer close()
+	ctx := context.Background()
+	q.setMode(ctx, false, repeatAll)
+	q.mu.Lock()
+	if err := q.play(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	q.mu.Unlock()
+	checkCalls(t, f, "SetAVTransportURI http://dms/0", "Play")
+	// Stopping before it started isn't the end of the item.
+	q.observe(ctx, dmc.Stopped, "")
+	checkCalls(t, f)
+	q.observe(ctx, dmc.Playing, "")
+	q.observe(ctx, dmc.Stopped, "")
+	checkCalls(t, f, "SetAVTransportURI http://dms/1", "Play")
+	// It wraps around.
+	q.observe(ctx, dmc.Playing, "")
+	q.observe(ctx, dmc.NoMediaPresent, "")
+	checkCalls(t, f, "SetAVTransportURI http://dms/0", "Play")
+	if err := q.skip(ctx, -1); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, f, "SetAVTransportURI http://dms/1", "Play")
+	if j := q.json(); j.Gapless || !j.Playing || j.Position != 1 {
+		t.Errorf("wrong state: %+v", j)
+	}
+}
+
+func TestQueueShuffle(t *testing.T) {
+	f := &queueTestRenderer{}
+	q, close := newTestQueue(t, f, 20)
+	defer close()
+	ctx := context.Background()
+	q.mu.Lock()
+	q.pos = 5
+	q.mu.Unlock()
+	q.setMode(ctx, true, repeatOff)
+	if q.pos != 0 || q.order[0] != 5 {
+		t.Errorf("current item moved: %v at %d", q.order, q.pos)
+	}
+	order := append([]int(nil), q.order...)
+	sort.Ints(order)
+	for i, j := range order {
+		if i != j {
+			t.Fatalf("not a permutation: %v", q.order)
+		}
+	}
+	checkCalls(t, f, fmt.Sprintf("SetNextAVTransportURI http://dms/%d", q.order[1]))
+	q.setMode(ctx, false, repeatOne)
+	if q.pos != 5 || q.order[5] != 5 {
+		t.Errorf("current item moved: %v at %d", q.order, q.pos)
+	}
+	checkCalls(t, f, "SetNextAVTransportURI http://dms/5")
+}
+
+// Events are acted on with a timeout from when they arrive, however long
+// the queue waited for them.
+func TestQueueFollowEvents(t *testing.T) {
+	f := &queueTestRenderer{}
+	q, stop := newTestQueue(t, f, 3)
+	defer stop()
+	q.timeout = 50 * time.Millisecond
+	q.mu.Lock()
+	if err := q.play(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	q.mu.Unlock()
+	checkCalls(t, f, "SetAVTransportURI http://dms/0", "Play", "SetNextAVTransportURI http://dms/1")
+	followed := make(chan struct{})
+	go func() {
+		defer close(followed)
+		q.follow()
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		q.srv.renderersMu.Lock()
+		sid := q.sid
+		q.srv.renderersMu.Unlock()
+		if sid != "" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("didn't subscribe")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	checkCalls(t, f, "SUBSCRIBE")
+	time.Sleep(2 * q.timeout)
+	q.events <- map[string]string{"LastChange": `<Event xmlns="urn:schemas-upnp-org:metadata-1-0/AVT/">` +
+		`<InstanceID val="0"><TransportState val="PLAYING"/><CurrentTrackURI val="http://dms/1"/></InstanceID></Event>`}
+	for {
+		q.mu.Lock()
+		pos := q.pos
+		q.mu.Unlock()
+		if pos == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("event not acted on")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	q.close()
+	<-followed
+	checkCalls(t, f, "SetNextAVTransportURI http://dms/2", "UNSUBSCRIBE")
+	if q.noSetNext || q.pos != 1 {
+		t.Errorf("queue at %d, noSetNext %v", q.pos, q.noSetNext)
+	}
+}
+
+func TestEventState(t *testing.T) {
+	state, uri := eventState(map[string]string{
+		"LastChange": `<Event xmlns="urn:schemas-upnp-org:metadata-1-0/AVT/">` +
+			`<InstanceID val="1"><TransportState val="STOPPED"/></InstanceID>` +
+			`<InstanceID val="0"><TransportState val="PLAYING"/><CurrentTrackURI val="http://dms/1"/></InstanceID>` +
+			`</Event>`,
+	})
+	if state != dmc.Playing || uri != "http://dms/1" {
+		t.Errorf("got %q %q", state, uri)
+	}
+}
+
+func TestServeQueueEvent(t *testing.T) {
+	q := newPlayQueue(&Server{}, &dmc.Renderer{}, "dms", nil)
+	q.sid = "uuid:sub"
+	srv := &Server{queues: map[string]*playQueue{"uuid:tv": q}}
+	notify := func(sid string) int {
+		r := httptest.NewRequest("NOTIFY", queueEventPath, strings.NewReader(
+			`<e:propertyset xmlns:e="urn:schemas-upnp-org:event-1-0"><e:property><LastChange>x</LastChange></e:property></e:propertyset>`))
+		r.Header.Set("SID", sid)
+		w := httptest.NewRecorder()
+		srv.serveQueueEvent(w, r)
+		return w.Code
+	}
+	if code := notify("uuid:other"); code != http.StatusPreconditionFailed {
+		t.Errorf("unknown subscription got %d", code)
+	}
+	if code := notify("uuid:sub"); code != http.StatusOK {
+		t.Fatalf("got %d", code)
+	}
+	if vars := <-q.events; vars["LastChange"] != "x" {
+		t.Errorf("got %q", vars)
+	}
+}
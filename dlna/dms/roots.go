@@ -0,0 +1,215 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/anacrolix/dms/upnpav"
+)
+
+// A directory served as a top-level container. Options restrict what's
+// served from it, in addition to the Server's.
+type Root struct {
+	// Title of the container. It's also the first element of the object
+	// paths below the root, so it must be unique and can't contain "/".
+	Name string `json:"name"`
+	// The directory served.
+	Path string `json:"path"`
+	// Media types served, such as "video" and "audio". All are served if
+	// it's empty.
+	MediaTypes []string `json:"mediaTypes,omitempty"`
+	// Patterns of file and directory names to ignore, as for filepath.Match.
+	// Everything in an ignored directory is ignored too.
+	Ignore           []string `json:"ignore,omitempty"`
+	IgnoreHidden     bool     `json:"ignoreHidden,omitempty"`
+	IgnoreUnreadable bool     `json:"ignoreUnreadable,omitempty"`
+	NoTranscode      bool     `json:"noTranscode,omitempty"`
+}
+
+// A Root, placed in the tree of objects.
+type rootMount struct {
+	Root
+	// The object path of the root's container: "/" for the RootObjectPath,
+	// or "/" and the Name for Roots.
+	objectPath string
+}
+
+// Returns the roots served: the Roots, or the RootObjectPath without a name.
+func (s Settings) roots() []rootMount {
+	if len(s.Roots) == 0 {
+		return []rootMount{{Root{Path: s.RootObjectPath}, "/"}}
+	}
+	ret := make([]rootMount, 0, len(s.Roots))
+	for _, r := range s.Roots {
+		ret = append(ret, rootMount{r, "/" + r.Name})
+	}
+	return ret
+}
+
+// Returns the object at a clean, absolute object path. With Roots, the
+// first element of the path names the root, and "/" is the container of
+// the roots.
+func (s Settings) object(p string) (o object, err error) {
+	o.Path = p
+	if p == "/" && len(s.Roots) != 0 {
+		return
+	}
+	name := strings.SplitN(p[1:], "/", 2)[0]
+	for _, r := range s.roots() {
+		if r.Name == "" || r.Name == name {
+			o.root = &r
+			return
+		}
+	}
+	err = &os.PathError{Op: "open", Path: p, Err: os.ErrNotExist}
+	return
+}
+
+// Returns the object for a file or directory below one of the roots, given
+// its clean, absolute file path.
+func (s Settings) fileObject(filePath string) (o object, ok bool) {
+	for _, r := range s.roots() {
+		r := r
+		rel, err := filepath.Rel(r.Path, filePath)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return object{path.Join(r.objectPath, filepath.ToSlash(rel)), &r}, true
+	}
+	return
+}
+
+// How names that are path elements, such as those of roots, must be.
+const pathNameRule = "must be unique and non-empty, can't be '.' or '..', and can't contain '/'"
+
+// Whether the name can be a path element. Cleaning paths would remove "."
+// and "..".
+func isPathName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.Contains(name, "/")
+}
+
+// Normalizes the roots' paths, and checks they can be served.
+func checkRoots(roots []Root) error {
+	names := make(map[string]bool)
+	for i := range roots {
+		r := &roots[i]
+		if !isPathName(r.Name) || names[r.Name] {
+			return fmt.Errorf("%w: root name %q %s", errBadSettings, r.Name, pathNameRule)
+		}
+		names[r.Name] = true
+		for _, pat := range r.Ignore {
+			if _, err := filepath.Match(pat, ""); err != nil {
+				return fmt.Errorf("%w: root %q: ignore pattern %q: %s", errBadSettings, r.Name, pat, err)
+			}
+		}
+		if err := checkDir(&r.Path); err != nil {
+			return fmt.Errorf("root %q: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+// Checks the roots, or the RootObjectPath if there are none, normalizing
+// their paths.
+func checkContent(roots []Root, rootObjectPath *string) error {
+	if len(roots) != 0 {
+		return checkRoots(roots)
+	}
+	return checkDir(rootObjectPath)
+}
+
+// Makes the path absolute, and checks it's a directory.
+func checkDir(p *string) (err error) {
+	if *p == "" {
+		return fmt.Errorf("%w: no path", errBadSettings)
+	}
+	if *p, err = filepath.Abs(*p); err != nil {
+		return
+	}
+	fi, err := os.Stat(*p)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errBadSettings, err)
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("%w: %s isn't a directory", errBadSettings, *p)
+	}
+	return nil
+}
+
+// Whether the root's options exclude the file or directory at filePath,
+// which is below the root.
+func (r Root) ignores(filePath string, isDir bool) (bool, error) {
+	if ignored, err := ignorePath(filePath, r.IgnoreHidden, r.IgnoreUnreadable); err != nil || ignored {
+		return ignored, err
+	}
+	if rel, err := filepath.Rel(r.Path, filePath); err == nil && len(r.Ignore) != 0 {
+		for _, name := range strings.Split(filepath.ToSlash(rel), "/") {
+			for _, pat := range r.Ignore {
+				if ok, _ := filepath.Match(pat, name); ok {
+					return true, nil
+				}
+			}
+		}
+	}
+	if isDir || len(r.MediaTypes) == 0 {
+		return false, nil
+	}
+	mt, err := MimeTypeByPath(filePath)
+	if err != nil {
+		return false, err
+	}
+	for _, t := range r.MediaTypes {
+		if t == mt.Type() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Whether the object's file is ignored, by the Server's options or by its
+// root's.
+func (srv *Server) ignoreObject(o object, isDir bool) (bool, error) {
+	filePath := o.FilePath()
+	ignored, err := srv.IgnorePath(filePath)
+	if err == nil && !ignored {
+		ignored, err = o.root.ignores(filePath, isDir)
+	}
+	return ignored, err
+}
+
+// Returns the container of the Roots.
+func (srv *Server) rootsContainer() upnpav.Container {
+	return upnpav.Container{Object: upnpav.Object{
+		ID:         "0",
+		ParentID:   "-1",
+		Restricted: 1,
+		Class:      "object.container.storageFolder",
+		Title:      srv.Settings().FriendlyName,
+	}}
+}
+
+// Whether the object is the top of a named root.
+func (o *object) isNamedRoot() bool {
+	return o.root != nil && o.root.Name != "" && o.Path == o.root.objectPath
+}
+
+// Returns the object for each of the Roots that can be served, and their
+// directories' info, for the container of the roots.
+func (me *contentDirectoryService) rootObjects() (objs []object, fis []os.FileInfo) {
+	for _, r := range me.Settings().roots() {
+		r := r
+		o := object{path.Join("/", r.Name), &r}
+		fi, err := os.Stat(o.FilePath())
+		if err != nil {
+			log.Printf("error with root %q: %s", r.Name, err)
+			continue
+		}
+		objs = append(objs, o)
+		fis = append(fis, fi)
+	}
+	return
+}
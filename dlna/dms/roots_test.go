@@ -0,0 +1,156 @@
+package dms
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newRootsTestServer(t *testing.T) (srv *Server, movies, music string) {
+	movies, music = t.TempDir(), t.TempDir()
+	writeTestTree(t, movies, map[string]string{"a.mp4": "data", "a.jpg": "data", "sample/b.mp4": "data"})
+	writeTestTree(t, music, map[string]string{"c.mp3": "data"})
+	srv = &Server{
+		FriendlyName: "dms",
+		NoProbe:      true,
+		Roots: []Root{
+			{Name: "Movies", Path: movies, MediaTypes: []string{"video"}, Ignore: []string{"sample"}},
+			{Name: "Music", Path: music, NoTranscode: true},
+		},
+	}
+	return
+}
+
+func TestRootsFilePath(t *testing.T) {
+	srv, movies, music := newRootsTestServer(t)
+	for given, expected := range map[string]string{
+		"/Movies/a.mp4":            filepath.Join(movies, "a.mp4"),
+		"Movies":                   movies,
+		"/Music/../Movies/a.mp4":   filepath.Join(movies, "a.mp4"),
+		"../../Music/c.mp3":        filepath.Join(music, "c.mp3"),
+		"/Movies/../../etc/passwd": "",
+		"/":                        "",
+		"/Other/a.mp4":             "",
+	} {
+		_, actual, err := srv.filePath(given)
+		if actual != expected || (err == nil) != (expected != "") {
+			t.Errorf("expected %q for %q but got %q, %v", expected, given, actual, err)
+		}
+	}
+}
+
+func TestRootsBrowse(t *testing.T) {
+	srv, _, _ := newRootsTestServer(t)
+	var l objectsJSON
+	if code := getJSON(t, srv.serveObject, objectsPath+"/0/children", &l); code != http.StatusOK {
+		t.Fatal(code)
+	}
+	if ids := objectIDs(l); len(ids) != 2 || ids[0] != "%2FMovies" || ids[1] != "%2FMusic" || l.Objects[0].Title != "Movies" || l.Objects[0].ParentID != "0" {
+		t.Fatalf("wrong roots: %+v", l)
+	}
+	var root objectJSON
+	if code := getJSON(t, srv.serveObject, objectsPath+"/0", &root); code != http.StatusOK || !root.Container || root.Title != "dms" {
+		t.Fatalf("wrong root: %d %+v", code, root)
+	}
+	// The picture isn't a video, and the samples are ignored.
+	l = objectsJSON{}
+	if code := getJSON(t, srv.serveObject, objectsPath+"/%252FMovies/children", &l); code != http.StatusOK {
+		t.Fatal(code)
+	}
+	if ids := objectIDs(l); len(ids) != 1 || ids[0] != "%2FMovies%2Fa.mp4" || l.Objects[0].ParentID != "%2FMovies" {
+		t.Fatalf("wrong movies: %+v", l)
+	}
+	l = objectsJSON{}
+	if code := getJSON(t, srv.serveSearch, searchPath+"?q=c", &l); code != http.StatusOK {
+		t.Fatal(code)
+	}
+	if ids := objectIDs(l); len(ids) != 2 || ids[0] != "%2FMusic" || ids[1] != "%2FMusic%2Fc.mp3" {
+		t.Fatalf("wrong search results: %+v", l)
+	}
+	if res := l.Objects[1].Resources; len(res) != 1 || res[0].URL != "http://dms/res?path=%2FMusic%2Fc.mp3" {
+		t.Fatalf("wrong resources: %+v", res)
+	}
+	mux := http.NewServeMux()
+	srv.initMux(mux)
+	for target, code := range map[string]int{
+		"/res?path=%2FMusic%2Fc.mp3":           http.StatusOK,
+		"/res?path=%2FMovies%2Fa.jpg":          http.StatusNotFound,
+		"/res?path=%2FMovies%2Fsample%2Fb.mp4": http.StatusNotFound,
+		"/res?path=%2Fc.mp3":                   http.StatusNotFound,
+	} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest("GET", "http://dms"+target, nil))
+		if w.Code != code {
+			t.Errorf("%s: expected %d but got %d", target, code, w.Code)
+		}
+	}
+}
+
+func TestApplyRoots(t *testing.T) {
+	srv, movies, _ := newRootsTestServer(t)
+	s := srv.Settings()
+	for _, name := range []string{"Movies", "", ".", "..", "a/b"} {
+		s.Roots = append(srv.Settings().Roots, Root{Name: name, Path: movies})
+		if err := srv.Apply(s); !errors.Is(err, errBadSettings) {
+			t.Errorf("root name %q applied: %v", name, err)
+		}
+	}
+	s.Roots = []Root{{Name: "Films", Path: movies}}
+	if err := srv.Apply(s); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := srv.filePath("/Movies/a.mp4"); err == nil {
+		t.Error("old root still served")
+	}
+	if _, _, err := srv.filePath("/Films/a.jpg"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInitChecksRoots(t *testing.T) {
+	_, movies, music := newRootsTestServer(t)
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel, err := filepath.Rel(wd, movies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, roots := range [][]Root{
+		{{Name: "Movies", Path: movies}, {Name: "Movies", Path: music}},
+		{{Name: "..", Path: movies}},
+		{{Name: "Movies", Path: filepath.Join(movies, "a.mp4")}},
+	} {
+		srv := &Server{Roots: roots, NoProbe: true}
+		if err := srv.Init(); !errors.Is(err, errBadSettings) {
+			t.Errorf("%+v: got %v", roots, err)
+		}
+	}
+	listen := func() net.Listener {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { l.Close() })
+		return l
+	}
+	srv := &Server{Roots: []Root{{Name: "Movies", Path: rel}}, NoProbe: true, HTTPConn: listen()}
+	if err := srv.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if p := srv.Roots[0].Path; p != movies {
+		t.Errorf("root path %q", p)
+	}
+	if _, _, err := srv.filePath("/Movies/a.mp4"); err != nil {
+		t.Error(err)
+	}
+	srv = &Server{RootObjectPath: rel, NoProbe: true, HTTPConn: listen()}
+	if err := srv.Init(); err != nil || srv.RootObjectPath != movies {
+		t.Errorf("RootObjectPath %q: %v", srv.RootObjectPath, err)
+	}
+}
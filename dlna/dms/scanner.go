@@ -0,0 +1,391 @@
+package dms
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/anacrolix/ffprobe"
+)
+
+const (
+	scanStatusPath = "/status/scan"
+	// Used when Server.ProbeWorkers isn't set.
+	defaultProbeWorkers = 2
+	// Used when Server.ProbeTimeout isn't set.
+	defaultProbeTimeout = 30 * time.Second
+	// Files seen between progress log lines.
+	scanLogInterval = 500
+)
+
+// ScanStatus describes the progress of the background library scanner. It's
+// served as JSON at /status/scan.
+type ScanStatus struct {
+	Running bool
+	// Number of completed scans.
+	Scans    int
+	Started  time.Time
+	Finished time.Time
+	// Media files found by the current or last scan.
+	Files int
+	// Files that were already in the probe cache.
+	Cached   int
+	Probed   int
+	Failed   int
+	TimedOut int
+	// Files requested by Browse that are waiting to be probed.
+	Pending int
+}
+
+// A version of a file, which changes when it's modified.
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+func fileVersionOf(fi os.FileInfo) fileVersion {
+	return fileVersion{fi.ModTime(), fi.Size()}
+}
+
+// A file for a worker to probe. Scans wait for the probes of the files they
+// find.
+type probeRequest struct {
+	path string
+	scan *sync.WaitGroup
+}
+
+// A probe that failed or timed out, which isn't retried until the file
+// changes.
+type probeFailure struct {
+	fileVersion
+	timedOut bool
+}
+
+// Walks the roots and probes media files in the background, so that
+// Browse only has to consult the probe cache.
+type scanner struct {
+	srv      *Server
+	workers  int
+	timeout  time.Duration
+	interval time.Duration
+	// Runs ffprobe, killing it if the context is done first.
+	probeFile func(ctx context.Context, path string) (*ffprobe.Info, error)
+
+	// Paths wanted by Browse that weren't in the cache. These jump ahead of
+	// the walk.
+	wanted chan string
+	work   chan probeRequest
+	// Starts a scan ahead of the interval, as when the root path changes.
+	rescan chan struct{}
+
+	mu     sync.Mutex
+	status ScanStatus
+	queued map[string]struct{}
+	// Failed probes by path. Failures aren't cached, so they're retried
+	// after a restart.
+	failures map[string]probeFailure
+	stopped  chan struct{}
+}
+
+func newScanner(srv *Server) *scanner {
+	s := &scanner{
+		srv:       srv,
+		workers:   srv.ProbeWorkers,
+		timeout:   srv.ProbeTimeout,
+		interval:  srv.ScanInterval,
+		probeFile: ffprobeContext,
+		wanted:    make(chan string, 1024),
+		work:      make(chan probeRequest),
+		rescan:    make(chan struct{}, 1),
+		queued:    make(map[string]struct{}),
+		failures:  make(map[string]probeFailure),
+		stopped:   make(chan struct{}),
+	}
+	if s.workers <= 0 {
+		s.workers = defaultProbeWorkers
+	}
+	if s.timeout <= 0 {
+		s.timeout = defaultProbeTimeout
+	}
+	return s
+}
+
+// Starts the probe workers and the scan loop. Everything stops when the
+// server is closed.
+func (s *scanner) run() {
+	// Kills running probes when the server is closed.
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-s.srv.closed
+		cancel()
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.worker(ctx)
+		}()
+	}
+	go s.feedWanted()
+	for {
+		s.scan()
+		var next <-chan time.Time
+		if s.interval > 0 {
+			next = time.After(s.interval)
+		}
+		select {
+		case <-next:
+		case <-s.rescan:
+		case <-s.srv.closed:
+		}
+		if s.closed() {
+			break
+		}
+	}
+	wg.Wait()
+	close(s.stopped)
+}
+
+// Starts another scan once the current one finishes.
+func (s *scanner) triggerRescan() {
+	select {
+	case s.rescan <- struct{}{}:
+	default:
+	}
+}
+
+func (s *scanner) closed() bool {
+	select {
+	case <-s.srv.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Passes paths requested by Browse to the workers, between scans as well as
+// during them.
+func (s *scanner) feedWanted() {
+	for {
+		select {
+		case path := <-s.wanted:
+			select {
+			case s.work <- probeRequest{path: path}:
+			case <-s.srv.closed:
+				return
+			}
+		case <-s.srv.closed:
+			return
+		}
+	}
+}
+
+// Queues a file for probing ahead of the walk. It doesn't block, and paths
+// already queued, or that failed to probe since they last changed, are
+// ignored.
+func (s *scanner) want(path string, fi os.FileInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.queued[path]; ok {
+		return
+	}
+	if _, ok := s.failed(path, fi); ok {
+		return
+	}
+	select {
+	case s.wanted <- path:
+		s.queued[path] = struct{}{}
+		s.status.Pending++
+	default:
+	}
+}
+
+// Returns the failed probe of the file, if it hasn't changed since. The
+// lock must be held.
+func (s *scanner) failed(path string, fi os.FileInfo) (f probeFailure, ok bool) {
+	f, ok = s.failures[path]
+	if ok && f.fileVersion != fileVersionOf(fi) {
+		delete(s.failures, path)
+		ok = false
+	}
+	return
+}
+
+func (s *scanner) worker(ctx context.Context) {
+	for {
+		select {
+		case req := <-s.work:
+			s.probe(ctx, req.path)
+			if req.scan != nil {
+				req.scan.Done()
+			}
+		case <-s.srv.closed:
+			return
+		}
+	}
+}
+
+// Walks the roots of the server and its virtual servers once, handing
+// uncached media files to the workers, and waits for their probes.
+func (s *scanner) scan() {
+	s.mu.Lock()
+	s.status.Running = true
+	s.status.Started = time.Now()
+	s.status.Files = 0
+	s.status.Cached = 0
+	s.status.Probed = 0
+	s.status.Failed = 0
+	s.status.TimedOut = 0
+	s.mu.Unlock()
+	var probes sync.WaitGroup
+	for _, srv := range s.srv.servers() {
+		for _, r := range srv.Settings().roots() {
+			s.scanRoot(srv, r.Root, &probes)
+		}
+	}
+	probes.Wait()
+	s.mu.Lock()
+	s.status.Running = false
+	s.status.Finished = time.Now()
+	s.status.Scans++
+	s.mu.Unlock()
+	s.logProgress()
+}
+
+// Walks one of the server's roots.
+func (s *scanner) scanRoot(srv *Server, r Root, probes *sync.WaitGroup) {
+	root := r.Path
+	log.Printf("scanning %q", root)
+	err := filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if s.closed() {
+			return filepath.SkipDir
+		}
+		if err != nil {
+			return nil
+		}
+		ignored, err := srv.IgnorePath(path)
+		if err == nil && !ignored {
+			ignored, err = r.ignores(path, fi.IsDir())
+		}
+		if err != nil || ignored {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		if mt, err := MimeTypeByPath(path); err != nil || !mt.IsMedia() {
+			return nil
+		}
+		_, cached, _ := s.srv.cachedFFmpegProbe(path)
+		s.mu.Lock()
+		s.status.Files++
+		files := s.status.Files
+		f, failed := s.failed(path, fi)
+		switch {
+		case cached:
+			s.status.Cached++
+		case failed && f.timedOut:
+			s.status.TimedOut++
+		case failed:
+			s.status.Failed++
+		}
+		s.mu.Unlock()
+		if files%scanLogInterval == 0 {
+			s.logProgress()
+		}
+		if cached || failed {
+			return nil
+		}
+		probes.Add(1)
+		select {
+		case s.work <- probeRequest{path, probes}:
+		case <-s.srv.closed:
+			probes.Done()
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("error scanning %q: %s", root, err)
+	}
+}
+
+func (s *scanner) logProgress() {
+	st := s.Status()
+	verb := "scanned"
+	if st.Running {
+		verb = "scanning"
+	}
+	log.Printf("%s %d media files: %d cached, %d probed, %d failed, %d timed out", verb, st.Files, st.Cached, st.Probed, st.Failed, st.TimedOut)
+}
+
+// Probes a file and stores the result in the cache. The probe is killed if
+// it takes longer than the timeout, or the context is done. Files that fail
+// are remembered until they change.
+func (s *scanner) probe(ctx context.Context, path string) {
+	s.mu.Lock()
+	if _, ok := s.queued[path]; ok {
+		delete(s.queued, path)
+		s.status.Pending--
+	}
+	s.mu.Unlock()
+	key, err := ffmpegProbeCacheKey(path)
+	if err != nil {
+		return
+	}
+	if _, ok := s.srv.FFProbeCache.Get(key); ok {
+		return
+	}
+	fi, err := os.Stat(key.Path)
+	if err != nil {
+		return
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	info, err := s.probeFile(probeCtx, key.Path)
+	timedOut := probeCtx.Err() == context.DeadlineExceeded
+	cancel()
+	if ctx.Err() != nil {
+		return
+	}
+	err = suppressFFmpegProbeDataErrors(err)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case timedOut:
+		log.Printf("probing %s timed out after %s", path, s.timeout)
+		s.status.TimedOut++
+		s.failures[path] = probeFailure{fileVersionOf(fi), true}
+	case err == ffprobe.ExeNotFound:
+		// Not cached, so that the files are probed once ffprobe is
+		// installed.
+	case err != nil:
+		log.Printf("error probing %s: %s", path, err)
+		s.status.Failed++
+		s.failures[path] = probeFailure{fileVersionOf(fi), false}
+	default:
+		s.srv.FFProbeCache.Set(key, info)
+		s.status.Probed++
+	}
+}
+
+// Status returns a snapshot of the scanner progress.
+func (s *scanner) Status() ScanStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+func (s *scanner) serveStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(s.Status()); err != nil {
+		log.Print(err)
+	}
+}
@@ -0,0 +1,190 @@
+package dms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anacrolix/ffprobe"
+)
+
+// Returns a scanner of a directory with the media files, that probes them
+// with probe. It's stopped when the test ends.
+func newTestScanner(t *testing.T, files []string, workers int, timeout time.Duration, probe func(ctx context.Context, path string) (*ffprobe.Info, error)) (s *scanner, dir string) {
+	dir = t.TempDir()
+	for _, name := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv := &Server{
+		RootObjectPath: dir,
+		BackgroundScan: true,
+		ProbeWorkers:   workers,
+		ProbeTimeout:   timeout,
+		FFProbeCache:   newMemoryFFProbeCache(),
+		closed:         make(chan struct{}),
+	}
+	s = newScanner(srv)
+	s.probeFile = probe
+	srv.scanner = s
+	go s.run()
+	t.Cleanup(func() {
+		close(srv.closed)
+		<-s.stopped
+	})
+	return
+}
+
+// Waits for the scanner to complete n scans.
+func waitScans(t *testing.T, s *scanner, n int) ScanStatus {
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		st := s.Status()
+		if st.Scans >= n && st.Pending == 0 {
+			return st
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("scan %d didn't finish: %+v", n, st)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestScannerWorkers(t *testing.T) {
+	var (
+		mu           sync.Mutex
+		running, max int
+	)
+	release := make(chan struct{})
+	s, _ := newTestScanner(t, []string{"0.mp3", "1.mp3", "2.mp3", "3.mp3", "4.mp3", "5.mp3", "6.mp3"}, 3, time.Minute, func(ctx context.Context, path string) (*ffprobe.Info, error) {
+		mu.Lock()
+		running++
+		if running > max {
+			max = running
+		}
+		mu.Unlock()
+		<-release
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return &ffprobe.Info{}, nil
+	})
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		mu.Lock()
+		r := running
+		mu.Unlock()
+		if r == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d probes running", r)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// Give any probes beyond the bound time to start.
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	st := waitScans(t, s, 1)
+	if max != 3 {
+		t.Errorf("%d concurrent probes with 3 workers", max)
+	}
+	if st.Files != 7 || st.Probed != 7 {
+		t.Errorf("wrong status: %+v", st)
+	}
+}
+
+func TestScannerFailures(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		probes = make(map[string]int)
+		killed bool
+	)
+	s, dir := newTestScanner(t, []string{"ok.mp3", "bad.mp3", "hang.mp3"}, 2, 20*time.Millisecond, func(ctx context.Context, path string) (*ffprobe.Info, error) {
+		mu.Lock()
+		probes[filepath.Base(path)]++
+		mu.Unlock()
+		switch filepath.Base(path) {
+		case "bad.mp3":
+			return nil, errors.New("invalid data")
+		case "hang.mp3":
+			<-ctx.Done()
+			mu.Lock()
+			killed = true
+			mu.Unlock()
+			return nil, ctx.Err()
+		}
+		return &ffprobe.Info{}, nil
+	})
+	st := waitScans(t, s, 1)
+	if st.Probed != 1 || st.Failed != 1 || st.TimedOut != 1 {
+		t.Fatalf("wrong status: %+v", st)
+	}
+	mu.Lock()
+	if !killed {
+		t.Error("timed out probe wasn't killed")
+	}
+	mu.Unlock()
+	// Failures aren't retried, by scans or Browse, while the files are
+	// unchanged.
+	hang := filepath.Join(dir, "hang.mp3")
+	fi, err := os.Stat(hang)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.want(hang, fi)
+	s.triggerRescan()
+	st = waitScans(t, s, 2)
+	if st.Cached != 1 || st.Probed != 0 || st.Failed != 1 || st.TimedOut != 1 {
+		t.Fatalf("wrong status: %+v", st)
+	}
+	mu.Lock()
+	if probes["ok.mp3"] != 1 || probes["bad.mp3"] != 1 || probes["hang.mp3"] != 1 {
+		t.Fatalf("files probed again: %v", probes)
+	}
+	mu.Unlock()
+	// Changed files are probed again.
+	later := time.Now().Add(time.Hour)
+	for _, name := range []string{"ok.mp3", "hang.mp3"} {
+		if err := os.Chtimes(filepath.Join(dir, name), later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.triggerRescan()
+	st = waitScans(t, s, 3)
+	if st.Probed != 1 || st.Failed != 1 || st.TimedOut != 1 {
+		t.Fatalf("wrong status: %+v", st)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if probes["ok.mp3"] != 2 || probes["bad.mp3"] != 1 || probes["hang.mp3"] != 2 {
+		t.Fatalf("wrong probes: %v", probes)
+	}
+}
+
+func TestScannerServeStatus(t *testing.T) {
+	s, _ := newTestScanner(t, []string{"a.mp3", "b.txt"}, 1, time.Minute, func(ctx context.Context, path string) (*ffprobe.Info, error) {
+		return &ffprobe.Info{}, nil
+	})
+	waitScans(t, s, 1)
+	w := httptest.NewRecorder()
+	s.serveStatus(w, httptest.NewRequest("GET", scanStatusPath, nil))
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("content type %q", ct)
+	}
+	var st ScanStatus
+	if err := json.NewDecoder(w.Body).Decode(&st); err != nil {
+		t.Fatal(err)
+	}
+	if st.Running || st.Scans != 1 || st.Files != 1 || st.Probed != 1 || st.Finished.IsZero() {
+		t.Fatalf("wrong status: %+v", st)
+	}
+}
@@ -0,0 +1,205 @@
+package dms
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dmc"
+)
+
+// Time a renderer's sink protocol infos are remembered, and before a client
+// without a renderer is looked up again.
+const sinkLifetime = time.Hour
+
+// A protocolInfo is the protocol, network, content format and additional
+// info fields of a UPnP protocol info, such as
+// "http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_PAL".
+type protocolInfo [4]string
+
+func parseProtocolInfo(s string) (ret protocolInfo, ok bool) {
+	fields := strings.SplitN(s, ":", 4)
+	if len(fields) != 4 {
+		return
+	}
+	copy(ret[:], fields)
+	return ret, true
+}
+
+// Parses the protocol infos of a sink list, skipping bad ones.
+func parseSinks(sink []string) (ret []protocolInfo) {
+	for _, s := range sink {
+		if pi, ok := parseProtocolInfo(s); ok {
+			ret = append(ret, pi)
+		}
+	}
+	return
+}
+
+// Returns the DLNA.ORG_PN profile name in the additional info, if any.
+func (pi protocolInfo) profileName() string {
+	for _, param := range strings.Split(pi[3], ";") {
+		if strings.HasPrefix(param, "DLNA.ORG_PN=") {
+			return param[len("DLNA.ORG_PN="):]
+		}
+	}
+	return ""
+}
+
+// Whether a renderer with the protocol info as a sink can play media of the
+// MIME type, fetched with HTTP. If pn is given, the media has that DLNA
+// profile, and it must match the sink's if it has one.
+func (pi protocolInfo) accepts(mt mimeType, pn string) bool {
+	if pi[0] != "http-get" && pi[0] != "*" {
+		return false
+	}
+	switch ct := strings.ToLower(pi[2]); {
+	case ct == "*", ct == string(mt):
+	case strings.HasSuffix(ct, "/*") && ct[:len(ct)-1] == mt.Type()+"/":
+	default:
+		return false
+	}
+	if pn == "" {
+		return true
+	}
+	sinkPN := pi.profileName()
+	return sinkPN == "" || sinkPN == pn
+}
+
+// A renderer's sink protocol infos, or none if the client has no renderer.
+type sinkEntry struct {
+	sinks   []protocolInfo
+	fetched time.Time
+	pending bool
+}
+
+// The sink protocol infos of renderers, by the IP address of the client.
+// Clients that browse are often renderers too, such as TVs.
+type sinkCache struct {
+	mu      sync.Mutex
+	entries map[string]*sinkEntry
+}
+
+func newSinkCache() *sinkCache {
+	return &sinkCache{entries: make(map[string]*sinkEntry)}
+}
+
+// Returns the sinks of the renderer at the IP, if they're known.
+func (c *sinkCache) get(ip net.IP) []protocolInfo {
+	if c == nil || ip == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[ip.String()]; ok {
+		return e.sinks
+	}
+	return nil
+}
+
+func (c *sinkCache) set(ip net.IP, sink []string) {
+	e := &sinkEntry{parseSinks(sink), time.Now(), false}
+	c.mu.Lock()
+	c.entries[ip.String()] = e
+	c.mu.Unlock()
+}
+
+// Marks a lookup of the IP as started, returning false if one isn't needed.
+func (c *sinkCache) start(ip net.IP) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[ip.String()]
+	if ok && (e.pending || time.Since(e.fetched) < sinkLifetime) {
+		return false
+	}
+	if !ok {
+		e = &sinkEntry{}
+		c.entries[ip.String()] = e
+	}
+	e.pending = true
+	return true
+}
+
+// Looks up the sinks of the renderer at the client's IP in the background,
+// if they aren't known.
+func (srv *Server) wantRendererSinks(ip net.IP) {
+	if srv.sinks == nil || ip == nil || !srv.sinks.start(ip) {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), rendererTimeout)
+		defer cancel()
+		sink, err := srv.lookupRendererSinks(ctx, ip)
+		if err != nil {
+			log.Printf("error getting protocol info of renderer at %s: %s", ip, err)
+		}
+		srv.sinks.set(ip, sink)
+	}()
+}
+
+// Returns the sink protocol infos of the renderer at the IP, or none if
+// there isn't one.
+func (srv *Server) lookupRendererSinks(ctx context.Context, ip net.IP) ([]string, error) {
+	atIP := func(rs []*dmc.Renderer) *dmc.Renderer {
+		for _, r := range rs {
+			if u, err := url.Parse(r.Location); err == nil && ip.Equal(net.ParseIP(u.Hostname())) {
+				return r
+			}
+		}
+		return nil
+	}
+	srv.renderersMu.Lock()
+	var known []*dmc.Renderer
+	for _, r := range srv.renderers {
+		known = append(known, r)
+	}
+	srv.renderersMu.Unlock()
+	r := atIP(known)
+	if r == nil {
+		rs, err := srv.discoverRenderers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if r = atIP(rs); r == nil {
+			return nil, nil
+		}
+	}
+	return rendererSinks(ctx, r)
+}
+
+// Returns the renderer's sink protocol infos, or none if it doesn't have a
+// ConnectionManager to say.
+func rendererSinks(ctx context.Context, r *dmc.Renderer) ([]string, error) {
+	sink, err := r.ProtocolInfo(ctx)
+	if err == dmc.ErrNoConnectionManager {
+		err = nil
+	}
+	return sink, err
+}
+
+// Returns the profile for the renderer, with its sink protocol infos,
+// fetching them if they aren't known.
+func (srv *Server) rendererProfile(ctx context.Context, r *dmc.Renderer) *Profile {
+	var ip net.IP
+	if u, err := url.Parse(r.Location); err == nil {
+		ip = net.ParseIP(u.Hostname())
+	}
+	sinks := srv.sinks.get(ip)
+	if sinks == nil {
+		sink, err := rendererSinks(ctx, r)
+		if err != nil {
+			log.Printf("error getting protocol info of %s: %s", r.FriendlyName, err)
+		} else if srv.sinks != nil && ip != nil {
+			srv.sinks.set(ip, sink)
+		}
+		sinks = parseSinks(sink)
+	}
+	c := client{ip, http.Header{}}
+	c.header.Set("FriendlyName.DLNA.ORG", r.FriendlyName)
+	return srv.matchProfile(c).withSinks(sinks)
+}
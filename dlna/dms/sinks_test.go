@@ -0,0 +1,107 @@
+package dms
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anacrolix/dms/upnpav"
+)
+
+func TestProtocolInfoAccepts(t *testing.T) {
+	for _, c := range []struct {
+		sink   string
+		mt     mimeType
+		pn     string
+		accept bool
+	}{
+		{"http-get:*:video/mp4:*", "video/mp4", "", true},
+		{"http-get:*:video/mp4:*", "video/mpeg", "", false},
+		{"http-get:*:video/*:*", "video/webm", "", true},
+		{"*:*:*:*", "audio/mpeg", "", true},
+		{"rtsp-rtp-udp:*:video/mp4:*", "video/mp4", "", false},
+		{"http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_PAL;DLNA.ORG_OP=01", "video/mpeg", "MPEG_PS_PAL", true},
+		{"http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_NTSC", "video/mpeg", "MPEG_PS_PAL", false},
+		{"http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_NTSC", "video/mpeg", "", true},
+	} {
+		pi, ok := parseProtocolInfo(c.sink)
+		if !ok {
+			t.Fatalf("%q didn't parse", c.sink)
+		}
+		if got := pi.accepts(c.mt, c.pn); got != c.accept {
+			t.Errorf("%q accepts %s %q: got %v", c.sink, c.mt, c.pn, got)
+		}
+	}
+	if _, ok := parseProtocolInfo("video/mp4"); ok {
+		t.Error("bad protocol info parsed")
+	}
+}
+
+// Returns the transcode of each of the item's resources, "" for the native
+// one, without the thumbnail.
+func resourceTranscodes(t *testing.T, obj interface{}) (ret []string) {
+	item, ok := obj.(upnpav.Item)
+	if !ok {
+		t.Fatalf("not an item: %+v", obj)
+	}
+	for _, res := range item.Res {
+		u, err := url.Parse(res.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Path == resPath {
+			ret = append(ret, u.Query().Get("transcode"))
+		}
+	}
+	return
+}
+
+func TestRendererSinkResources(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.mp4"), []byte("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	srv := &Server{RootObjectPath: dir, NoProbe: true}
+	srv.initCaches()
+	cds := &contentDirectoryService{Server: srv}
+	browse := func() []string {
+		objs, _, _, err := cds.browseObjects(httptest.NewRequest("POST", "http://dms/ctl", nil), "0", "BrowseDirectChildren", "", 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resourceTranscodes(t, objs[0])
+	}
+	// The order is the same every time without sinks.
+	for i := 0; i < 3; i++ {
+		if got := strings.Join(browse(), ","); got != ",chromecast,vp8,t" {
+			t.Fatalf("got resources %q", got)
+		}
+	}
+	// httptest's client address.
+	ip := net.ParseIP("192.0.2.1")
+	for _, c := range []struct {
+		sink []string
+		want string
+	}{
+		// Transcodes the renderer can't decode are dropped.
+		{[]string{"http-get:*:video/mp4:*", "http-get:*:audio/mpeg:*"}, ",chromecast"},
+		// The native resource is offered last, in case the renderer plays
+		// more than it says.
+		{[]string{"http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_PAL", "http-get:*:video/webm:*"}, "vp8,t,"},
+		{[]string{"http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_NTSC"}, ""},
+	} {
+		srv.sinks.set(ip, c.sink)
+		if got := strings.Join(browse(), ","); got != c.want {
+			t.Errorf("%q: got resources %q, expected %q", c.sink, got, c.want)
+		}
+	}
+	// A profile's MIME types and transcodes override the sinks.
+	srv.Profiles = []Profile{{Name: "TV", MimeTypes: []string{"video/mpeg", "video/webm"}, Transcodes: []string{"t", "vp8"}}}
+	if got := strings.Join(browse(), ","); got != "t,vp8" {
+		t.Errorf("got resources %q", got)
+	}
+}
@@ -0,0 +1,378 @@
+package dms
+
+import (
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anacrolix/dms/ssdp"
+)
+
+// An interface with these flags should be valid for SSDP.
+const ssdpInterfaceFlags = net.FlagUp | net.FlagMulticast
+
+// Bursts of interface changes, such as while DHCP completes, are handled
+// together after this delay.
+const ssdpReconcileDelay = time.Second
+
+// Identifies an SSDP server: one runs per interface and IP version.
+type ssdpKey struct {
+	ifName string
+	v6     bool
+}
+
+func (k ssdpKey) String() string {
+	if k.v6 {
+		return k.ifName + " (IPv6)"
+	}
+	return k.ifName
+}
+
+type ssdpInstance struct {
+	key    ssdpKey
+	server *ssdp.Server
+	// The interface index and addresses the server was started with. The
+	// server is restarted if they change.
+	signature string
+	stopped   chan struct{}
+}
+
+// Whether the server was started by an earlier reconciliation.
+func (inst *ssdpInstance) serving() bool {
+	return inst.stopped != nil
+}
+
+// Whether the server stopped serving without being stopped.
+func (inst *ssdpInstance) failed() bool {
+	if !inst.serving() {
+		return false
+	}
+	select {
+	case <-inst.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// The network SSDP is served on. Tests use a fake one.
+type ssdpNetwork interface {
+	Interfaces() ([]net.Interface, error)
+	Addrs(net.Interface) ([]net.Addr, error)
+	// Returns the connection for the SSDP server on the interface, or nil
+	// for the server to make its own multicast socket.
+	Conn(ifi net.Interface, v6 bool) (ssdp.PacketConn, error)
+	// Calls changed whenever interfaces or their addresses may have changed,
+	// until closed is closed.
+	Watch(closed <-chan struct{}, changed func())
+}
+
+type systemNetwork struct{}
+
+func (systemNetwork) Interfaces() ([]net.Interface, error) { return net.Interfaces() }
+
+func (systemNetwork) Addrs(ifi net.Interface) ([]net.Addr, error) { return ifi.Addrs() }
+
+func (systemNetwork) Conn(net.Interface, bool) (ssdp.PacketConn, error) { return nil, nil }
+
+func (systemNetwork) Watch(closed <-chan struct{}, changed func()) {
+	watchInterfaces(closed, changed)
+}
+
+func (me *Server) network() ssdpNetwork {
+	if me.ssdpNetwork == nil {
+		return systemNetwork{}
+	}
+	return me.ssdpNetwork
+}
+
+// Runs SSDP servers for the usable interfaces, starting and stopping them as
+// interfaces and addresses come and go, until the server is closed.
+func (me *Server) doSSDP() {
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	go me.network().Watch(me.closed, notify)
+	running := make(map[ssdpKey]*ssdpInstance)
+	configIDs := me.currentConfigIDs()
+	me.reconcileSSDP(running, notify)
+	for {
+		select {
+		case <-changed:
+		case <-me.reconfigureSSDP:
+			configIDs = me.reconfigureRunningSSDP(running, configIDs)
+			continue
+		case <-me.closed:
+			for _, inst := range running {
+				me.stopSSDP(inst)
+			}
+			return
+		}
+		select {
+		case <-time.After(ssdpReconcileDelay):
+		case <-me.closed:
+			continue
+		}
+		me.reconcileSSDP(running, notify)
+	}
+}
+
+// Announces the CONFIGIDs of the devices that changed since they were
+// announced with those given on the running servers. Returns the current
+// CONFIGIDs.
+func (me *Server) reconfigureRunningSSDP(running map[ssdpKey]*ssdpInstance, announced map[string]uint32) map[string]uint32 {
+	current := me.currentConfigIDs()
+	for uuid, configID := range current {
+		if configID == announced[uuid] {
+			continue
+		}
+		for _, inst := range running {
+			if !inst.serving() {
+				continue
+			}
+			if err := inst.server.ReconfigureDevice(uuid, configID); err != nil {
+				log.Printf("error announcing new CONFIGID on %s: %s", inst.key, err)
+			}
+		}
+	}
+	return current
+}
+
+func (me *Server) currentConfigID() uint32 {
+	me.settingsMu.RLock()
+	defer me.settingsMu.RUnlock()
+	return me.configID
+}
+
+// Returns the CONFIGIDs of the server and its virtual servers, by device
+// UUID.
+func (me *Server) currentConfigIDs() map[string]uint32 {
+	ret := make(map[string]uint32)
+	for _, s := range me.servers() {
+		ret[s.rootDeviceUUID] = s.currentConfigID()
+	}
+	return ret
+}
+
+// Whether SSDP should be run on the interface at all.
+func (me *Server) ssdpInterfaceWanted(if_ net.Interface) bool {
+	if if_.Flags&net.FlagUp == 0 || if_.MTU <= 0 {
+		return false
+	}
+	if me.Interfaces == nil {
+		return true
+	}
+	for _, allowed := range me.Interfaces {
+		if allowed.Name == if_.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the SSDP servers that should be running, with their signatures.
+func (me *Server) wantedSSDP() (map[ssdpKey]net.Interface, map[ssdpKey]string) {
+	ifs, err := me.network().Interfaces()
+	if err != nil {
+		log.Printf("error listing interfaces: %s", err)
+		return nil, nil
+	}
+	ifaces := make(map[ssdpKey]net.Interface)
+	sigs := make(map[ssdpKey]string)
+	for _, if_ := range ifs {
+		if !me.ssdpInterfaceWanted(if_) {
+			continue
+		}
+		addrs, err := me.network().Addrs(if_)
+		if err != nil {
+			log.Printf("error getting addresses of %s: %s", if_.Name, err)
+			continue
+		}
+		var v4, v6 []string
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok {
+				continue
+			}
+			if ipNet.IP.To4() != nil {
+				v4 = append(v4, ipNet.String())
+			} else {
+				v6 = append(v6, ipNet.String())
+			}
+		}
+		for _, a := range []struct {
+			v6    bool
+			addrs []string
+		}{{false, v4}, {true, v6}} {
+			if len(a.addrs) == 0 {
+				continue
+			}
+			// IPv6 multicast doesn't work on loopback interfaces at least.
+			if a.v6 && (me.NoIPv6 || if_.Flags&net.FlagMulticast == 0) {
+				continue
+			}
+			sort.Strings(a.addrs)
+			k := ssdpKey{if_.Name, a.v6}
+			ifaces[k] = if_
+			sigs[k] = strings.Join(append([]string{strconv.Itoa(if_.Index)}, a.addrs...), " ")
+		}
+	}
+	return ifaces, sigs
+}
+
+// Brings the running SSDP servers in line with the current interfaces.
+// Servers on vanished interfaces say byebye, and those whose addresses
+// changed, or that failed, are restarted. If servers are added while others
+// are running, the BOOTID is bumped and announced to the existing ones with
+// ssdp:update. failed is called when a server fails, to reconcile again.
+func (me *Server) reconcileSSDP(running map[ssdpKey]*ssdpInstance, failed func()) {
+	ifaces, sigs := me.wantedSSDP()
+	if ifaces == nil {
+		return
+	}
+	for k, inst := range running {
+		if sigs[k] == inst.signature && !inst.failed() {
+			continue
+		}
+		me.stopSSDP(inst)
+		delete(running, k)
+	}
+	var added []*ssdpInstance
+	for k, if_ := range ifaces {
+		if _, ok := running[k]; ok {
+			continue
+		}
+		inst := me.newSSDP(k, if_)
+		inst.signature = sigs[k]
+		if inst.server != nil {
+			added = append(added, inst)
+		}
+		running[k] = inst
+	}
+	if len(added) == 0 {
+		return
+	}
+	for _, inst := range running {
+		if inst.serving() {
+			me.bumpBootID(running, added)
+			break
+		}
+	}
+	for _, inst := range added {
+		me.serveSSDP(inst, failed)
+	}
+}
+
+// Increments the BOOTID, announcing it on the servers already running first.
+func (me *Server) bumpBootID(running map[ssdpKey]*ssdpInstance, added []*ssdpInstance) {
+	next := me.bootID + 1
+	for _, inst := range running {
+		if !inst.serving() {
+			continue
+		}
+		if err := inst.server.Update(next); err != nil {
+			log.Printf("error sending ssdp:update on %s: %s", inst.key, err)
+		}
+	}
+	for _, inst := range added {
+		inst.server.BootID = next
+	}
+	me.bootID = next
+	if me.StatePath != "" {
+		// Apply updates the state too.
+		me.settingsMu.Lock()
+		me.deviceState.BootID = next
+		err := me.deviceState.save(me.StatePath)
+		me.settingsMu.Unlock()
+		if err != nil {
+			log.Printf("error saving state: %s", err)
+		}
+	}
+}
+
+// Creates an SSDP server. Its server is nil if the interface isn't usable,
+// and it won't be retried until the interface's addresses change.
+func (me *Server) newSSDP(k ssdpKey, if_ net.Interface) *ssdpInstance {
+	inst := &ssdpInstance{key: k}
+	network := me.network()
+	conn, err := network.Conn(if_, k.v6)
+	if err != nil {
+		log.Printf("error creating ssdp connection on %s: %s", k, err)
+		return inst
+	}
+	s := &ssdp.Server{
+		Conn:      conn,
+		Interface: if_,
+		Addrs: func() ([]net.Addr, error) {
+			return network.Addrs(if_)
+		},
+		Devices:  devices(),
+		Services: serviceTypes(),
+		Location: func(ip net.IP) string {
+			return me.location(ip)
+		},
+		Server:         serverField,
+		UUID:           me.rootDeviceUUID,
+		NotifyInterval: me.NotifyInterval,
+		BootID:         me.bootID,
+		ConfigID:       me.currentConfigID(),
+		IPv6:           k.v6,
+	}
+	for _, vs := range me.VirtualServers {
+		s.OtherDevices = append(s.OtherDevices, &ssdp.Device{
+			UUID:     vs.rootDeviceUUID,
+			Devices:  devices(),
+			Services: serviceTypes(),
+			Location: vs.location,
+			ConfigID: vs.currentConfigID(),
+		})
+	}
+	if err := s.Init(); err != nil {
+		if if_.Flags&ssdpInterfaceFlags != ssdpInterfaceFlags {
+			// Didn't expect it to work anyway.
+			return inst
+		}
+		if strings.Contains(err.Error(), "listen") {
+			// OSX has a lot of dud interfaces. Failure to create a socket on
+			// the interface are what we're expecting if the interface is no
+			// good.
+			return inst
+		}
+		log.Printf("error creating ssdp server on %s: %s", k, err)
+		return inst
+	}
+	inst.server = s
+	return inst
+}
+
+// Starts announcing and responding on a created SSDP server. failed is
+// called if it stops with an error.
+func (me *Server) serveSSDP(inst *ssdpInstance, failed func()) {
+	log.Println("started SSDP on", inst.key)
+	inst.stopped = make(chan struct{})
+	go func() {
+		err := inst.server.Serve()
+		close(inst.stopped)
+		if err != nil {
+			log.Printf("%q: %q\n", inst.key, err)
+			failed()
+		}
+	}()
+}
+
+// Stops an SSDP server. Closing it sends byebye messages.
+func (me *Server) stopSSDP(inst *ssdpInstance) {
+	if inst.server == nil {
+		return
+	}
+	inst.server.Close()
+	<-inst.stopped
+	log.Println("stopped SSDP on", inst.key)
+}
@@ -0,0 +1,267 @@
+package dms
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anacrolix/dms/ssdp"
+)
+
+// An SSDP connection that records what's sent on it.
+type fakeSSDPConn struct {
+	mu     sync.Mutex
+	sent   []string
+	closed chan struct{}
+	once   sync.Once
+}
+
+func (c *fakeSSDPConn) ReadFromUDP(b []byte) (int, *net.UDPAddr, error) {
+	<-c.closed
+	return 0, nil, errors.New("use of closed connection")
+}
+
+func (c *fakeSSDPConn) WriteToUDP(b []byte, addr *net.UDPAddr) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sent = append(c.sent, string(b))
+	return len(b), nil
+}
+
+func (c *fakeSSDPConn) Close() error {
+	c.once.Do(func() { close(c.closed) })
+	return nil
+}
+
+func (c *fakeSSDPConn) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Waits for a message containing all the strings to be sent.
+func (c *fakeSSDPConn) waitFor(t *testing.T, strs ...string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		c.mu.Lock()
+		for _, msg := range c.sent {
+			matches := true
+			for _, s := range strs {
+				matches = matches && strings.Contains(msg, s)
+			}
+			if matches {
+				c.mu.Unlock()
+				return
+			}
+		}
+		c.mu.Unlock()
+		if time.Now().After(deadline) {
+			t.Fatalf("no message with %q sent", strs)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// A network whose interfaces the test changes.
+type fakeNetwork struct {
+	mu       sync.Mutex
+	ifaces   []net.Interface
+	addrs    map[string][]net.Addr
+	addrsErr map[string]error
+	conns    map[ssdpKey][]*fakeSSDPConn
+}
+
+func newFakeNetwork() *fakeNetwork {
+	return &fakeNetwork{
+		addrs:    make(map[string][]net.Addr),
+		addrsErr: make(map[string]error),
+		conns:    make(map[ssdpKey][]*fakeSSDPConn),
+	}
+}
+
+func (n *fakeNetwork) add(name string, index int, cidr string) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	ipNet.IP = ip
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.ifaces = append(n.ifaces, net.Interface{Index: index, MTU: 1500, Name: name, Flags: net.FlagUp | net.FlagMulticast})
+	n.addrs[name] = []net.Addr{ipNet}
+}
+
+func (n *fakeNetwork) remove(name string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for i, ifi := range n.ifaces {
+		if ifi.Name == name {
+			n.ifaces = append(n.ifaces[:i], n.ifaces[i+1:]...)
+			break
+		}
+	}
+}
+
+func (n *fakeNetwork) setAddrsErr(name string, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.addrsErr[name] = err
+}
+
+// Returns the index'th connection made for the interface's IPv4 server.
+func (n *fakeNetwork) conn(t *testing.T, name string, index int) *fakeSSDPConn {
+	t.Helper()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	conns := n.conns[ssdpKey{name, false}]
+	if index >= len(conns) {
+		t.Fatalf("%d connections made on %s", len(conns), name)
+	}
+	return conns[index]
+}
+
+func (n *fakeNetwork) Interfaces() ([]net.Interface, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]net.Interface(nil), n.ifaces...), nil
+}
+
+func (n *fakeNetwork) Addrs(ifi net.Interface) ([]net.Addr, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.addrs[ifi.Name], n.addrsErr[ifi.Name]
+}
+
+func (n *fakeNetwork) Conn(ifi net.Interface, v6 bool) (ssdp.PacketConn, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	c := &fakeSSDPConn{closed: make(chan struct{})}
+	k := ssdpKey{ifi.Name, v6}
+	n.conns[k] = append(n.conns[k], c)
+	return c, nil
+}
+
+func (n *fakeNetwork) Watch(closed <-chan struct{}, changed func()) {
+	<-closed
+}
+
+func TestReconcileSSDP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	network := newFakeNetwork()
+	srv := &Server{
+		HTTPConn:       l,
+		rootDeviceUUID: "uuid:test",
+		bootID:         1,
+		NotifyInterval: 20 * time.Millisecond,
+		ssdpNetwork:    network,
+	}
+	running := make(map[ssdpKey]*ssdpInstance)
+	failures := make(chan struct{}, 10)
+	reconcile := func() {
+		srv.reconcileSSDP(running, func() {
+			failures <- struct{}{}
+		})
+	}
+	defer func() {
+		for _, inst := range running {
+			srv.stopSSDP(inst)
+		}
+	}()
+	network.add("eth0", 1, "192.168.1.2/24")
+	reconcile()
+	eth0 := network.conn(t, "eth0", 0)
+	eth0.waitFor(t, "NTS: ssdp:alive", "LOCATION: http://192.168.1.2:", "\r\nBOOTID.UPNP.ORG: 1\r\n")
+	// Another interface bumps the BOOTID, which is announced on the first.
+	network.add("eth1", 2, "10.0.0.2/8")
+	reconcile()
+	eth0.waitFor(t, "NTS: ssdp:update", "NEXTBOOTID.UPNP.ORG: 2\r\n")
+	network.conn(t, "eth1", 0).waitFor(t, "NTS: ssdp:alive", "LOCATION: http://10.0.0.2:", "\r\nBOOTID.UPNP.ORG: 2\r\n")
+	if srv.bootID != 2 {
+		t.Fatalf("BOOTID is %d", srv.bootID)
+	}
+	// A vanished interface's server says byebye.
+	network.remove("eth0")
+	reconcile()
+	eth0.waitFor(t, "NTS: ssdp:byebye")
+	if !eth0.isClosed() {
+		t.Fatal("connection wasn't closed")
+	}
+	if _, ok := running[ssdpKey{"eth0", false}]; ok || len(running) != 1 {
+		t.Fatalf("wrong servers running: %v", running)
+	}
+	// A server that fails is restarted by the next reconcile.
+	network.setAddrsErr("eth1", errors.New("no addresses"))
+	select {
+	case <-failures:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server didn't fail")
+	}
+	network.setAddrsErr("eth1", nil)
+	reconcile()
+	network.conn(t, "eth1", 1).waitFor(t, "NTS: ssdp:alive")
+}
+
+func TestMonitorInterfaces(t *testing.T) {
+	type timer struct {
+		d time.Duration
+		c chan time.Time
+	}
+	for _, watchErr := range []error{nil, errors.New("not supported")} {
+		timers := make(chan timer)
+		after := func(d time.Duration) <-chan time.Time {
+			tm := timer{d, make(chan time.Time, 1)}
+			timers <- tm
+			return tm.c
+		}
+		watchStopped := make(chan struct{})
+		watch := func(closed <-chan struct{}, changed func()) (<-chan struct{}, error) {
+			if watchErr != nil {
+				return nil, watchErr
+			}
+			return watchStopped, nil
+		}
+		changes := make(chan struct{}, 1)
+		closed := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			monitorInterfaces(closed, func() {
+				changes <- struct{}{}
+			}, watch, after)
+		}()
+		want := ifWatchPollInterval
+		if watchErr != nil {
+			want = ifPollInterval
+		}
+		tm := <-timers
+		if tm.d != want {
+			t.Fatalf("watch error %v: polling every %s", watchErr, tm.d)
+		}
+		// Polls call changed.
+		tm.c <- time.Time{}
+		<-changes
+		if tm = <-timers; tm.d != want {
+			t.Fatalf("watch error %v: polling every %s", watchErr, tm.d)
+		}
+		if watchErr == nil {
+			// Polling speeds up if watching stops.
+			close(watchStopped)
+			if tm = <-timers; tm.d != ifPollInterval {
+				t.Fatalf("polling every %s after watching stopped", tm.d)
+			}
+		}
+		close(closed)
+		<-done
+	}
+}
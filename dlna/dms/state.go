@@ -0,0 +1,148 @@
+package dms
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anacrolix/dms/upnp"
+)
+
+// CONFIGID.UPNP.ORG values must be below 2^24.
+const maxConfigID = 1<<24 - 1
+
+// Device identity that must survive restarts. It's kept as JSON at
+// Server.StatePath.
+type deviceState struct {
+	UUID   string
+	BootID uint32
+	// Identifies the device and service descriptions to control points.
+	ConfigID uint32
+	// Hash of the descriptions ConfigID was assigned for.
+	DescriptionHash []byte
+}
+
+func loadDeviceState(path string) (st deviceState, err error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(b, &st)
+	return
+}
+
+func (st deviceState) save(path string) error {
+	b, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+func newDeviceUUID() string {
+	var buf [16]byte
+	if _, err := io.ReadFull(rand.Reader, buf[:]); err != nil {
+		panic(err)
+	}
+	// RFC 4122 version 4.
+	buf[6] = buf[6]&0x0f | 0x40
+	buf[8] = buf[8]&0x3f | 0x80
+	return upnp.FormatUUID(buf[:])
+}
+
+// Adds the "uuid:" prefix to UUIDs given without it.
+func normalizeDeviceUUID(s string) string {
+	if s == "" || strings.HasPrefix(s, "uuid:") {
+		return s
+	}
+	return "uuid:" + s
+}
+
+// Hashes everything a CONFIGID covers: the device description and the
+// service descriptions.
+func descriptionHash(rootDescXML []byte) []byte {
+	h := sha1.New()
+	h.Write(rootDescXML)
+	for _, s := range services {
+		io.WriteString(h, s.SCPD)
+	}
+	return h.Sum(nil)
+}
+
+// Sets the device UUID and BOOTID. Without a state file the UUID is derived
+// from the friendly name, and the BOOTID is the startup time.
+func (srv *Server) initDeviceState() (err error) {
+	if srv.StatePath == "" {
+		srv.rootDeviceUUID = normalizeDeviceUUID(srv.DeviceUUID)
+		if srv.rootDeviceUUID == "" {
+			srv.rootDeviceUUID = makeDeviceUuid(srv.FriendlyName)
+		}
+		srv.bootID = uint32(time.Now().Unix())
+		return
+	}
+	st, err := loadDeviceState(srv.StatePath)
+	if err != nil && !os.IsNotExist(err) {
+		return
+	}
+	if srv.DeviceUUID != "" {
+		st.UUID = normalizeDeviceUUID(srv.DeviceUUID)
+	}
+	if st.UUID == "" {
+		st.UUID = newDeviceUUID()
+	}
+	st.BootID++
+	srv.rootDeviceUUID = st.UUID
+	srv.bootID = st.BootID
+	srv.deviceState = st
+	return st.save(srv.StatePath)
+}
+
+// Assigns the CONFIGID for the current descriptions, bumping it if they've
+// changed since it was last assigned. Without a state file it's taken from a
+// hash of the descriptions. Returns true if it changed.
+func (srv *Server) updateConfigID() (changed bool, err error) {
+	hash := descriptionHash(srv.rootDescXML)
+	if srv.StatePath == "" {
+		next := binary.BigEndian.Uint32(hash) & maxConfigID
+		changed = next != srv.configID
+		srv.configID = next
+		return
+	}
+	st := &srv.deviceState
+	if string(st.DescriptionHash) == string(hash) {
+		srv.configID = st.ConfigID
+		return
+	}
+	if st.DescriptionHash != nil {
+		st.ConfigID = (st.ConfigID + 1) & maxConfigID
+		changed = true
+	}
+	st.DescriptionHash = hash
+	srv.configID = st.ConfigID
+	err = st.save(srv.StatePath)
+	return
+}
@@ -0,0 +1,53 @@
+package dms
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDeviceStatePersists(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	statePath := filepath.Join(dir, "state.json")
+	start := func(friendlyName string) *Server {
+		srv := &Server{FriendlyName: friendlyName, StatePath: statePath}
+		if err := srv.initDeviceState(); err != nil {
+			t.Fatal(err)
+		}
+		srv.rootDescXML = []byte(friendlyName)
+		if _, err := srv.updateConfigID(); err != nil {
+			t.Fatal(err)
+		}
+		return srv
+	}
+	a := start("a")
+	b := start("b")
+	if a.rootDeviceUUID != b.rootDeviceUUID {
+		t.Fatalf("UUID changed with the friendly name: %s, %s", a.rootDeviceUUID, b.rootDeviceUUID)
+	}
+	if b.bootID != a.bootID+1 {
+		t.Fatalf("BOOTID not incremented: %d, %d", a.bootID, b.bootID)
+	}
+	if b.configID != a.configID+1 {
+		t.Fatalf("CONFIGID not bumped for changed description: %d, %d", a.configID, b.configID)
+	}
+	c := start("b")
+	if c.configID != b.configID {
+		t.Fatalf("CONFIGID bumped for unchanged description: %d, %d", b.configID, c.configID)
+	}
+}
+
+func TestDeviceUUIDOverride(t *testing.T) {
+	srv := &Server{DeviceUUID: "01234567-89ab-cdef-0123-456789abcdef"}
+	if err := srv.initDeviceState(); err != nil {
+		t.Fatal(err)
+	}
+	if srv.rootDeviceUUID != "uuid:01234567-89ab-cdef-0123-456789abcdef" {
+		t.Fatal(srv.rootDeviceUUID)
+	}
+}
@@ -0,0 +1,98 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Virtual servers are served below here, by name.
+const virtualPath = "/virtual"
+
+// Returns the server and its virtual servers.
+func (srv *Server) servers() []*Server {
+	return append([]*Server{srv}, srv.VirtualServers...)
+}
+
+// Whether the UDN is that of the server or one of its virtual servers.
+func (srv *Server) isOwnDevice(udn string) bool {
+	for _, s := range srv.servers() {
+		if s.rootDeviceUUID == udn {
+			return true
+		}
+	}
+	return false
+}
+
+// Prepares the virtual servers with what they share with the server, and
+// mounts their handlers below their paths.
+func (srv *Server) initVirtualServers() (err error) {
+	uuids := map[string]bool{srv.rootDeviceUUID: true}
+	names := make(map[string]bool)
+	for _, vs := range srv.VirtualServers {
+		if !isPathName(vs.Name) || names[vs.Name] {
+			return fmt.Errorf("virtual server name %q %s", vs.Name, pathNameRule)
+		}
+		names[vs.Name] = true
+		if err = vs.initVirtual(srv); err != nil {
+			return fmt.Errorf("virtual server %q: %w", vs.Name, err)
+		}
+		if uuids[vs.rootDeviceUUID] {
+			return fmt.Errorf("virtual server %q: device UUID %s is already used", vs.Name, vs.rootDeviceUUID)
+		}
+		uuids[vs.rootDeviceUUID] = true
+		srv.httpServeMux.Handle(vs.pathPrefix+"/", http.StripPrefix(vs.pathPrefix, vs.httpServeMux))
+		log.Printf("virtual server %q at %s", vs.FriendlyName, vs.pathPrefix)
+	}
+	return
+}
+
+// Init for a virtual server of parent, which has been inited.
+func (vs *Server) initVirtual(parent *Server) (err error) {
+	if err = vs.initServices(); err != nil {
+		return
+	}
+	if err = checkAccessRules(vs.AccessRules); err != nil {
+		return
+	}
+	if err = checkContent(vs.Roots, &vs.RootObjectPath); err != nil {
+		return
+	}
+	vs.pathPrefix = virtualPath + "/" + vs.Name
+	vs.closed = parent.closed
+	vs.HTTPConn = parent.HTTPConn
+	vs.FFProbeCache = parent.FFProbeCache
+	vs.NoProbe = parent.NoProbe
+	vs.LogHeaders = parent.LogHeaders
+	vs.StallEventSubscribe = parent.StallEventSubscribe
+	vs.Profiles = parent.Profiles
+	vs.PlaylistURLs = parent.PlaylistURLs
+	vs.UI = parent.UI
+	if len(vs.Icons) == 0 {
+		vs.Icons = parent.Icons
+	}
+	vs.thumbnails = parent.thumbnails
+	vs.dirListings = parent.dirListings
+	vs.sinks = parent.sinks
+	vs.scanner = parent.scanner
+	vs.reconfigureSSDP = parent.reconfigureSSDP
+	vs.systemUpdateID = parent.systemUpdateID
+	if vs.FriendlyName == "" {
+		vs.FriendlyName = vs.Name
+	}
+	// The CONFIGID is taken from the descriptions' hash, so it's stable
+	// without a state of its own.
+	vs.StatePath = ""
+	vs.rootDeviceUUID = normalizeDeviceUUID(vs.DeviceUUID)
+	if vs.rootDeviceUUID == "" {
+		vs.rootDeviceUUID = makeDeviceUuid(parent.rootDeviceUUID + virtualPath + "/" + vs.Name)
+	}
+	vs.bootID = parent.bootID
+	if _, err = vs.updateRootDesc(); err != nil {
+		return
+	}
+	vs.httpServeMux = http.NewServeMux()
+	vs.initMux(vs.httpServeMux)
+	vs.inited = true
+	return
+}
@@ -0,0 +1,89 @@
+package dms
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anacrolix/dms/upnp"
+)
+
+func newVirtualTestServer(t *testing.T, vss ...*Server) *Server {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	return &Server{
+		HTTPConn:       l,
+		FriendlyName:   "main",
+		RootObjectPath: t.TempDir(),
+		NoProbe:        true,
+		VirtualServers: vss,
+	}
+}
+
+func TestVirtualServers(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.mp3"), []byte("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	kids := &Server{Name: "kids", FriendlyName: "Kids", RootObjectPath: dir, NoTranscode: true}
+	srv := newVirtualTestServer(t, kids)
+	if err := srv.Init(); err != nil {
+		t.Fatal(err)
+	}
+	get := func(target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		srv.httpServeMux.ServeHTTP(w, httptest.NewRequest("GET", "http://dms"+target, nil))
+		return w
+	}
+	w := get("/virtual/kids/rootDesc.xml")
+	var desc upnp.DeviceDesc
+	if err := xml.Unmarshal(w.Body.Bytes(), &desc); err != nil {
+		t.Fatal(err)
+	}
+	if d := desc.Device; d.FriendlyName != "Kids" || d.UDN == srv.rootDeviceUUID || d.UDN != kids.rootDeviceUUID {
+		t.Fatalf("wrong device: %+v", d)
+	}
+	if s := desc.Device.ServiceList[0]; s.ControlURL != "/virtual/kids/ctl" || !strings.HasPrefix(s.SCPDURL, "/virtual/kids/") {
+		t.Fatalf("wrong service URLs: %+v", s)
+	}
+	if w := get(desc.Device.ServiceList[0].SCPDURL); w.Code != http.StatusOK {
+		t.Fatal(w.Code)
+	}
+	if !srv.isOwnDevice(kids.rootDeviceUUID) || srv.isOwnDevice("uuid:other") {
+		t.Fatal("wrong own devices")
+	}
+	var l objectsJSON
+	if code := getJSON(t, kids.serveObject, objectsPath+"/0/children", &l); code != http.StatusOK {
+		t.Fatal(code)
+	}
+	if len(l.Objects) != 1 || len(l.Objects[0].Resources) != 1 || l.Objects[0].Resources[0].URL != "http://dms/virtual/kids/res?path=%2Fa.mp3" {
+		t.Fatalf("wrong children: %+v", l)
+	}
+	if w := get("/virtual/kids/res?path=%2Fa.mp3"); w.Code != http.StatusOK || w.Body.String() != "data" {
+		t.Fatalf("%d %q", w.Code, w.Body)
+	}
+	// The main server doesn't serve the virtual server's files.
+	if w := get("/res?path=%2Fa.mp3"); w.Code != http.StatusNotFound {
+		t.Fatal(w.Code)
+	}
+}
+
+func TestVirtualServerNames(t *testing.T) {
+	for _, names := range [][]string{{""}, {"."}, {".."}, {"a/b"}, {"a", "a"}} {
+		var vss []*Server
+		for _, name := range names {
+			vss = append(vss, &Server{Name: name, RootObjectPath: t.TempDir()})
+		}
+		if err := newVirtualTestServer(t, vss...).Init(); err == nil {
+			t.Errorf("%q accepted", names)
+		}
+	}
+}
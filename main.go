@@ -1,11 +1,12 @@
 package main
 
-//go:generate go-bindata data/
+//go:generate go-bindata data/...
 
 import (
 	"bytes"
 	"encoding/json"
 	"flag"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"net"
@@ -13,13 +14,12 @@ import (
 	"os/signal"
 	"os/user"
 	"path/filepath"
-	"runtime"
-	"sync"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/anacrolix/dms/dlna/dms"
-	"github.com/anacrolix/dms/rrcache"
+	"github.com/anacrolix/dms/probecache"
 )
 
 type dmsConfig struct {
@@ -35,6 +35,37 @@ type dmsConfig struct {
 	NotifyInterval      time.Duration
 	IgnoreHidden        bool
 	IgnoreUnreadable    bool
+	BackgroundScan      bool
+	ScanInterval        time.Duration
+	ProbeWorkers        int
+	ProbeTimeout        time.Duration
+	DeviceUUID          string
+	StatePath           string
+	NoIPv6              bool
+	Aggregate           bool
+	PlaylistURLs        bool
+	AdminPassword       string
+	// Served instead of Path if there are any.
+	Roots       []dms.Root
+	AccessRules []dms.AccessRule
+	// Client profiles, tried before the built-in ones.
+	Profiles []dms.Profile
+	// More media servers to run, each advertised as a device of its own.
+	Servers []serverConfig
+}
+
+// A virtual server, served at /virtual/<Name>.
+type serverConfig struct {
+	Name             string
+	FriendlyName     string
+	DeviceUUID       string
+	Path             string
+	Roots            []dms.Root
+	AccessRules      []dms.AccessRule
+	NoTranscode      bool
+	IgnoreHidden     bool
+	IgnoreUnreadable bool
+	AdminPassword    string
 }
 
 func (config *dmsConfig) load(configPath string) {
@@ -60,6 +91,7 @@ var config = &dmsConfig{
 	FriendlyName:     "",
 	LogHeaders:       false,
 	FFprobeCachePath: getDefaultFFprobeCachePath(),
+	StatePath:        getDefaultStatePath(),
 }
 
 func getDefaultFFprobeCachePath() (path string) {
@@ -72,35 +104,160 @@ func getDefaultFFprobeCachePath() (path string) {
 	return
 }
 
-type fFprobeCache struct {
-	c *rrcache.RRCache
-	sync.Mutex
+func getDefaultStatePath() (path string) {
+	_user, err := user.Current()
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	path = filepath.Join(_user.HomeDir, ".dms", "state.json")
+	return
 }
 
-func (fc *fFprobeCache) Get(key interface{}) (value interface{}, ok bool) {
-	fc.Lock()
-	defer fc.Unlock()
-	return fc.c.Get(key)
+// Writes settings changed at runtime back to the config file, keeping the
+// rest of its contents. A serverName gives the settings of that virtual
+// server.
+func saveSettings(configPath, serverName string, s dms.Settings) error {
+	m := make(map[string]json.RawMessage)
+	b, err := ioutil.ReadFile(configPath)
+	if err == nil {
+		err = json.Unmarshal(b, &m)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if serverName == "" {
+		err = mergeSettings(m, s)
+	} else {
+		err = mergeServerSettings(m, serverName, s)
+	}
+	if err != nil {
+		return err
+	}
+	b, err = json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(configPath), filepath.Base(configPath))
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(b, '\n'))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), configPath)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
 }
 
-func (fc *fFprobeCache) Set(key interface{}, value interface{}) {
-	fc.Lock()
-	defer fc.Unlock()
-	var size int64
-	for _, v := range []interface{}{key, value} {
-		b, err := json.Marshal(v)
-		if err != nil {
-			log.Printf("Could not marshal %v: %s", v, err)
+// Merges a virtual server's settings into its entry in the Servers.
+func mergeServerSettings(m map[string]json.RawMessage, name string, s dms.Settings) error {
+	for k, v := range m {
+		if !strings.EqualFold(k, "Servers") {
 			continue
 		}
-		size += int64(len(b))
+		var servers []map[string]json.RawMessage
+		if err := json.Unmarshal(v, &servers); err != nil {
+			return err
+		}
+		for _, sm := range servers {
+			var sc serverConfig
+			b, err := json.Marshal(sm)
+			if err == nil {
+				err = json.Unmarshal(b, &sc)
+			}
+			if err != nil {
+				return err
+			}
+			if sc.Name != name {
+				continue
+			}
+			if err := mergeSettings(sm, s); err != nil {
+				return err
+			}
+			m[k], err = json.Marshal(servers)
+			return err
+		}
+	}
+	return fmt.Errorf("server %q isn't in the config file", name)
+}
+
+// Sets the settings' keys in a config object.
+func mergeSettings(m map[string]json.RawMessage, s dms.Settings) (err error) {
+	for k, v := range map[string]interface{}{
+		"FriendlyName":     s.FriendlyName,
+		"Path":             s.RootObjectPath,
+		"NoTranscode":      s.NoTranscode,
+		"IgnoreHidden":     s.IgnoreHidden,
+		"IgnoreUnreadable": s.IgnoreUnreadable,
+		"Roots":            s.Roots,
+		"AccessRules":      s.AccessRules,
+	} {
+		// Keys match fields regardless of case when the file is loaded.
+		for ek := range m {
+			if strings.EqualFold(ek, k) {
+				delete(m, ek)
+			}
+		}
+		switch v := v.(type) {
+		case []dms.Root:
+			if len(v) == 0 {
+				continue
+			}
+		case []dms.AccessRule:
+			if len(v) == 0 {
+				continue
+			}
+		}
+		if m[k], err = json.Marshal(v); err != nil {
+			return err
+		}
 	}
-	fc.c.Set(key, value, size)
+	return nil
+}
+
+// Returns the web UI's files from data/ui, by their path below it.
+func uiFiles() map[string]dms.UIFile {
+	const dir = "data/ui"
+	ret := make(map[string]dms.UIFile)
+	for _, name := range AssetNames() {
+		if !strings.HasPrefix(name, dir+"/") {
+			continue
+		}
+		info, err := AssetInfo(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ret[strings.TrimPrefix(name, dir)] = dms.UIFile{
+			ModTime: info.ModTime(),
+			Content: MustAsset(name),
+		}
+	}
+	return ret
+}
+
+// Commands other than serving, run as "dms <command> [flags]".
+var commands = map[string]func(args []string){
+	"discover": discoverMain,
+	"cast":     castMain,
+	"browse":   browseMain,
 }
 
 func main() {
 	log.SetFlags(log.Ltime | log.Lshortfile)
 
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			cmd(os.Args[2:])
+			return
+		}
+	}
+
 	path := flag.String("path", config.Path, "browse root path")
 	ifName := flag.String("ifname", config.IfName, "specific SSDP network interface")
 	http := flag.String("http", config.Http, "http server port")
@@ -108,12 +265,22 @@ func main() {
 	logHeaders := flag.Bool("logHeaders", config.LogHeaders, "log HTTP headers")
 	fFprobeCachePath := flag.String("fFprobeCachePath", config.FFprobeCachePath, "path to FFprobe cache file")
 	configFilePath := flag.String("config", "", "json configuration file")
+	deviceUUID := flag.String("deviceUUID", config.DeviceUUID, "device UUID, overriding the one in the state file")
+	statePath := flag.String("statePath", config.StatePath, "path to file keeping the device UUID et al. across restarts")
 	flag.BoolVar(&config.NoTranscode, "noTranscode", false, "disable transcoding")
 	flag.BoolVar(&config.NoProbe, "noProbe", false, "disable media probing with ffprobe")
 	flag.BoolVar(&config.StallEventSubscribe, "stallEventSubscribe", false, "workaround for some bad event subscribers")
 	flag.DurationVar(&config.NotifyInterval, "notifyInterval", 30*time.Second, "interval between SSPD announces")
 	flag.BoolVar(&config.IgnoreHidden, "ignoreHidden", false, "ignore hidden files and directories")
+	flag.BoolVar(&config.NoIPv6, "noIPv6", false, "don't use SSDP over IPv6")
 	flag.BoolVar(&config.IgnoreUnreadable, "ignoreUnreadable", false, "ignore unreadable files and directories")
+	flag.BoolVar(&config.BackgroundScan, "backgroundScan", false, "probe media in the background instead of while browsing")
+	flag.DurationVar(&config.ScanInterval, "scanInterval", 0, "interval between background scans, 0 to scan only at startup")
+	flag.IntVar(&config.ProbeWorkers, "probeWorkers", 2, "number of concurrent background probes")
+	flag.DurationVar(&config.ProbeTimeout, "probeTimeout", 30*time.Second, "time allowed for each background probe")
+	flag.BoolVar(&config.Aggregate, "aggregate", false, "re-export the content of other media servers on the network")
+	flag.BoolVar(&config.PlaylistURLs, "playlistURLs", false, "serve the http URLs in playlists, not only the files")
+	flag.StringVar(&config.AdminPassword, "adminPassword", "", "enable the admin page, settings API and renderer API with this password")
 
 	flag.Parse()
 	if flag.NArg() != 0 {
@@ -127,42 +294,28 @@ func main() {
 	config.FriendlyName = *friendlyName
 	config.LogHeaders = *logHeaders
 	config.FFprobeCachePath = *fFprobeCachePath
+	config.DeviceUUID = *deviceUUID
+	config.StatePath = *statePath
 
 	if len(*configFilePath) > 0 {
 		config.load(*configFilePath)
 	}
 
-	cache := &fFprobeCache{
-		c: rrcache.New(64 << 20),
-	}
-	if err := cache.load(config.FFprobeCachePath); err != nil {
-		log.Print(err)
+	var cache dms.Cache
+	store, err := probecache.Open(config.FFprobeCachePath, probecache.Options{})
+	if err != nil {
+		log.Printf("error opening probe cache: %s", err)
+	} else {
+		cache = store
 	}
 
 	dmsServer := &dms.Server{
-		Interfaces: func(ifName string) (ifs []net.Interface) {
-			var err error
+		Interfaces: func(ifName string) []net.Interface {
 			if ifName == "" {
-				ifs, err = net.Interfaces()
-			} else {
-				var if_ *net.Interface
-				if_, err = net.InterfaceByName(ifName)
-				if if_ != nil {
-					ifs = append(ifs, *if_)
-				}
-			}
-			if err != nil {
-				log.Fatal(err)
+				return nil
 			}
-			var tmp []net.Interface
-			for _, if_ := range ifs {
-				if if_.Flags&net.FlagUp == 0 || if_.MTU <= 0 {
-					continue
-				}
-				tmp = append(tmp, if_)
-			}
-			ifs = tmp
-			return
+			// The interface needn't exist yet, it's watched for by name.
+			return []net.Interface{{Name: ifName}}
 		}(config.IfName),
 		HTTPConn: func() net.Listener {
 			conn, err := net.Listen("tcp", config.Http)
@@ -173,6 +326,8 @@ func main() {
 		}(),
 		FriendlyName:   config.FriendlyName,
 		RootObjectPath: filepath.Clean(config.Path),
+		Roots:          config.Roots,
+		AccessRules:    config.AccessRules,
 		FFProbeCache:   cache,
 		LogHeaders:     config.LogHeaders,
 		NoTranscode:    config.NoTranscode,
@@ -193,10 +348,45 @@ func main() {
 				ReadSeeker: bytes.NewReader(MustAsset("data/VGC Sonic 128.png")),
 			},
 		},
+		UI:                  uiFiles(),
 		StallEventSubscribe: config.StallEventSubscribe,
+		Profiles:            config.Profiles,
 		NotifyInterval:      config.NotifyInterval,
 		IgnoreHidden:        config.IgnoreHidden,
 		IgnoreUnreadable:    config.IgnoreUnreadable,
+		BackgroundScan:      config.BackgroundScan,
+		ScanInterval:        config.ScanInterval,
+		ProbeWorkers:        config.ProbeWorkers,
+		ProbeTimeout:        config.ProbeTimeout,
+		DeviceUUID:          config.DeviceUUID,
+		StatePath:           config.StatePath,
+		NoIPv6:              config.NoIPv6,
+		Aggregate:           config.Aggregate,
+		PlaylistURLs:        config.PlaylistURLs,
+		AdminPassword:       config.AdminPassword,
+	}
+	for _, sc := range config.Servers {
+		vs := &dms.Server{
+			Name:             sc.Name,
+			FriendlyName:     sc.FriendlyName,
+			DeviceUUID:       sc.DeviceUUID,
+			RootObjectPath:   filepath.Clean(sc.Path),
+			Roots:            sc.Roots,
+			AccessRules:      sc.AccessRules,
+			NoTranscode:      sc.NoTranscode,
+			IgnoreHidden:     sc.IgnoreHidden,
+			IgnoreUnreadable: sc.IgnoreUnreadable,
+			AdminPassword:    sc.AdminPassword,
+		}
+		dmsServer.VirtualServers = append(dmsServer.VirtualServers, vs)
+	}
+	if *configFilePath != "" {
+		for _, s := range append([]*dms.Server{dmsServer}, dmsServer.VirtualServers...) {
+			name := s.Name
+			s.SaveSettings = func(s dms.Settings) error {
+				return saveSettings(*configFilePath, name, s)
+			}
+		}
 	}
 	go func() {
 		if err := dmsServer.Serve(); err != nil {
@@ -206,62 +396,15 @@ func main() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
 	<-sigs
-	err := dmsServer.Close()
+	err = dmsServer.Close()
 	if err != nil {
 		log.Fatal(err)
 	}
-	if err := cache.save(config.FFprobeCachePath); err != nil {
-		log.Print(err)
-	}
-}
-
-func (cache *fFprobeCache) load(path string) error {
-	f, err := os.Open(path)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	dec := json.NewDecoder(f)
-	var items []dms.FfprobeCacheItem
-	err = dec.Decode(&items)
-	if err != nil {
-		return err
-	}
-	for _, item := range items {
-		cache.Set(item.Key, item.Value)
-	}
-	log.Printf("added %d items from cache", len(items))
-	return nil
-}
-
-func (cache *fFprobeCache) save(path string) error {
-	cache.Lock()
-	items := cache.c.Items()
-	cache.Unlock()
-	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
-	if err != nil {
-		return err
-	}
-	enc := json.NewEncoder(f)
-	err = enc.Encode(items)
-	f.Close()
-	if err != nil {
-		os.Remove(f.Name())
-		return err
-	}
-	if runtime.GOOS == "windows" {
-		err = os.Remove(path)
-		if err == os.ErrNotExist {
-			err = nil
+	if store != nil {
+		st := store.Stats()
+		log.Printf("probe cache: %d hits, %d misses, %d evictions", st.Hits, st.Misses, st.Evictions)
+		if err := store.Close(); err != nil {
+			log.Print(err)
 		}
 	}
-	if err == nil {
-		err = os.Rename(f.Name(), path)
-	}
-	if err == nil {
-		log.Printf("saved cache with %d items", len(items))
-	} else {
-		os.Remove(f.Name())
-	}
-	return err
 }
@@ -0,0 +1,241 @@
+// Package playlist reads playlist files, in the M3U, PLS, XSPF and WPL
+// formats, and writes M3U and XSPF playlists.
+package playlist
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits the size of playlists read.
+const maxSize = 4 << 20
+
+// An Entry is an item of a playlist. The Location is as the playlist gives
+// it: a path, absolute or relative to the playlist's directory, or a URL.
+// Paths may use either kind of slash.
+type Entry struct {
+	Location string
+	Title    string
+}
+
+// Format returns the format of a playlist file from its name, such as "m3u",
+// or "" if it isn't a playlist.
+func Format(name string) string {
+	switch ext := strings.ToLower(path.Ext(name)); ext {
+	case ".m3u", ".m3u8", ".pls", ".xspf", ".wpl":
+		return ext[1:]
+	}
+	return ""
+}
+
+// Parse reads a playlist in the format, as returned by Format.
+func Parse(r io.Reader, format string) ([]Entry, error) {
+	b, err := ioutil.ReadAll(io.LimitReader(r, maxSize))
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case "m3u", "m3u8":
+		// M3U files without the 8 are often Latin-1.
+		return parseM3U(toUTF8(b)), nil
+	case "pls":
+		return parsePLS(toUTF8(b)), nil
+	case "xspf":
+		return parseXSPF(b)
+	case "wpl":
+		return parseWPL(b)
+	}
+	return nil, fmt.Errorf("unknown playlist format %q", format)
+}
+
+// Returns the text, decoding it as Latin-1 if it isn't UTF-8, without any
+// byte order mark.
+func toUTF8(b []byte) string {
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(b) {
+		return string(b)
+	}
+	rs := make([]rune, len(b))
+	for i, c := range b {
+		rs[i] = rune(c)
+	}
+	return string(rs)
+}
+
+// Calls f with each line of the text, trimmed of space, skipping empty
+// ones.
+func eachLine(s string, f func(string)) {
+	sc := bufio.NewScanner(strings.NewReader(s))
+	sc.Buffer(nil, maxSize)
+	for sc.Scan() {
+		if line := strings.TrimSpace(sc.Text()); line != "" {
+			f(line)
+		}
+	}
+}
+
+func parseM3U(s string) (ret []Entry) {
+	var title string
+	eachLine(s, func(line string) {
+		if strings.HasPrefix(line, "#EXTINF:") {
+			// #EXTINF:<seconds>,<title>
+			if i := strings.IndexByte(line, ','); i >= 0 {
+				title = strings.TrimSpace(line[i+1:])
+			}
+			return
+		}
+		if strings.HasPrefix(line, "#") {
+			return
+		}
+		ret = append(ret, Entry{line, title})
+		title = ""
+	})
+	return
+}
+
+// PLS is an INI file, with FileN, TitleN and LengthN keys, where N counts
+// from 1.
+func parsePLS(s string) (ret []Entry) {
+	entries := make(map[int]*Entry)
+	eachLine(s, func(line string) {
+		i := strings.IndexByte(line, '=')
+		if i < 0 {
+			return
+		}
+		key, value := strings.ToLower(strings.TrimSpace(line[:i])), strings.TrimSpace(line[i+1:])
+		var field string
+		switch {
+		case strings.HasPrefix(key, "file"):
+			field = "file"
+		case strings.HasPrefix(key, "title"):
+			field = "title"
+		default:
+			return
+		}
+		n, err := strconv.Atoi(key[len(field):])
+		if err != nil {
+			return
+		}
+		e := entries[n]
+		if e == nil {
+			e = &Entry{}
+			entries[n] = e
+		}
+		if field == "file" {
+			e.Location = value
+		} else {
+			e.Title = value
+		}
+	})
+	ns := make([]int, 0, len(entries))
+	for n := range entries {
+		ns = append(ns, n)
+	}
+	sort.Ints(ns)
+	for _, n := range ns {
+		if e := entries[n]; e.Location != "" {
+			ret = append(ret, *e)
+		}
+	}
+	return
+}
+
+// XSPF locations are URIs. Relative ones and file URLs are returned as
+// paths.
+func parseXSPF(b []byte) (ret []Entry, err error) {
+	var doc struct {
+		Tracks []struct {
+			Locations []string `xml:"location"`
+			Title     string   `xml:"title"`
+		} `xml:"trackList>track"`
+	}
+	if err = xml.Unmarshal(b, &doc); err != nil {
+		return
+	}
+	for _, t := range doc.Tracks {
+		if len(t.Locations) == 0 {
+			continue
+		}
+		loc := strings.TrimSpace(t.Locations[0])
+		if u, err := url.Parse(loc); err == nil {
+			if u.Scheme == "file" || u.Scheme == "" {
+				loc = u.Path
+			}
+		}
+		ret = append(ret, Entry{loc, strings.TrimSpace(t.Title)})
+	}
+	return
+}
+
+// WPL, Windows Media Player's playlist, is SMIL with a media element for
+// each entry.
+func parseWPL(b []byte) (ret []Entry, err error) {
+	var doc struct {
+		Media []struct {
+			Src string `xml:"src,attr"`
+		} `xml:"body>seq>media"`
+	}
+	if err = xml.Unmarshal(b, &doc); err != nil {
+		return
+	}
+	for _, m := range doc.Media {
+		if m.Src != "" {
+			ret = append(ret, Entry{Location: m.Src})
+		}
+	}
+	return
+}
+
+// WriteM3U writes an extended M3U playlist of the entries, in UTF-8.
+func WriteM3U(w io.Writer, entries []Entry) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("#EXTM3U\n")
+	for _, e := range entries {
+		if e.Title != "" {
+			fmt.Fprintf(bw, "#EXTINF:-1,%s\n", oneLine(e.Title))
+		}
+		fmt.Fprintf(bw, "%s\n", oneLine(e.Location))
+	}
+	return bw.Flush()
+}
+
+// Replaces line breaks, which would end an M3U line.
+var oneLine = strings.NewReplacer("\r", " ", "\n", " ").Replace
+
+// WriteXSPF writes an XSPF playlist with the title. The entries' locations
+// must be URIs.
+func WriteXSPF(w io.Writer, title string, entries []Entry) error {
+	type track struct {
+		Location string `xml:"location"`
+		Title    string `xml:"title,omitempty"`
+	}
+	doc := struct {
+		XMLName   xml.Name `xml:"http://xspf.org/ns/0/ playlist"`
+		Version   int      `xml:"version,attr"`
+		Title     string   `xml:"title,omitempty"`
+		TrackList []track  `xml:"trackList>track"`
+	}{Version: 1, Title: title}
+	for _, e := range entries {
+		doc.TrackList = append(doc.TrackList, track{e.Location, e.Title})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	if err := e.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
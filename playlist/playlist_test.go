@@ -0,0 +1,88 @@
+package playlist
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, c := range []struct {
+		name, content string
+		entries       []Entry
+	}{
+		{"a.m3u8", "\xef\xbb\xbf#EXTM3U\r\n#EXTINF:123,Artist - Song\r\nMusic/song.mp3\r\n\r\n# comment\r\n/abs/other.mp3\r\n", []Entry{
+			{"Music/song.mp3", "Artist - Song"},
+			{"/abs/other.mp3", ""},
+		}},
+		// Latin-1.
+		{"b.M3U", "Caf\xe9.mp3\nhttp://radio/stream\n", []Entry{
+			{"Café.mp3", ""},
+			{"http://radio/stream", ""},
+		}},
+		{"c.pls", "[playlist]\nFile2=b.mp3\nTitle1=A\nFile1=a.mp3\nLength1=10\nNumberOfEntries=2\nVersion=2\n", []Entry{
+			{"a.mp3", "A"},
+			{"b.mp3", ""},
+		}},
+		{"d.xspf", `<?xml version="1.0" encoding="UTF-8"?>
+<playlist version="1" xmlns="http://xspf.org/ns/0/">
+  <trackList>
+    <track><location>file:///music/a%20b.mp3</location><title>A B</title></track>
+    <track><location>sub/c%20d.mp3</location></track>
+    <track><location>http://host/e.mp3?x=1</location></track>
+    <track><title>no location</title></track>
+  </trackList>
+</playlist>`, []Entry{
+			{"/music/a b.mp3", "A B"},
+			{"sub/c d.mp3", ""},
+			{"http://host/e.mp3?x=1", ""},
+		}},
+		{"e.wpl", `<?wpl version="1.0"?>
+<smil>
+  <head><title>Mix</title></head>
+  <body>
+    <seq>
+      <media src="..\Music\a &amp; b.mp3"/>
+      <media src="C:\Music\c.wma" tid="{x}"/>
+    </seq>
+  </body>
+</smil>`, []Entry{
+			{`..\Music\a & b.mp3`, ""},
+			{`C:\Music\c.wma`, ""},
+		}},
+	} {
+		entries, err := Parse(strings.NewReader(c.content), Format(c.name))
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		if !reflect.DeepEqual(entries, c.entries) {
+			t.Errorf("%s: got %q, expected %q", c.name, entries, c.entries)
+		}
+	}
+	if Format("a.mp3") != "" || Format("dir/List.XSPF") != "xspf" {
+		t.Error("wrong formats")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	entries := []Entry{{"http://dms/res?path=%2Fa.mp3", "A\nB"}, {"http://dms/res?path=%2Fb.mp3", ""}}
+	var buf bytes.Buffer
+	if err := WriteM3U(&buf, entries); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "#EXTM3U\n#EXTINF:-1,A B\nhttp://dms/res?path=%2Fa.mp3\nhttp://dms/res?path=%2Fb.mp3\n" {
+		t.Fatalf("got %q", got)
+	}
+	buf.Reset()
+	if err := WriteXSPF(&buf, "Music", entries); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Parse(&buf, "xspf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Location != entries[0].Location || got[0].Title != "A\nB" {
+		t.Fatalf("got %q", got)
+	}
+}
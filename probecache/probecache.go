@@ -0,0 +1,379 @@
+// Package probecache implements a durable store for ffprobe results. Every
+// change is appended to a journal file, so a crash loses at most the changes
+// since the last flush. The journal is compacted when it holds too many
+// superseded records. When the store is full, the least recently used
+// entries are evicted.
+package probecache
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/anacrolix/dms/dlna/dms"
+	"github.com/anacrolix/dms/rrcache"
+	"github.com/anacrolix/ffprobe"
+)
+
+const (
+	DefaultCapacity      = 100000
+	DefaultFlushInterval = 10 * time.Second
+	DefaultPruneInterval = time.Hour
+	// The journal is compacted once it has this many more records than the
+	// store has entries.
+	compactSlack = 1024
+)
+
+type Options struct {
+	// Maximum number of entries.
+	Capacity int
+	// Time between writes of buffered journal records to disk.
+	FlushInterval time.Duration
+	// Time between checks for entries whose files were removed or modified.
+	// Negative disables pruning, except when the store is opened.
+	PruneInterval time.Duration
+}
+
+// A journal record. A set stores Value under Key, a delete removes Key.
+type record struct {
+	dms.FfprobeCacheItem
+	Delete bool `json:",omitempty"`
+}
+
+// Store keeps ffprobe results keyed by dms.FfprobeCacheKey. It implements
+// dms.Cache and is safe for concurrent use.
+type Store struct {
+	path string
+	opts Options
+
+	// Guards the journal, and orders changes to the cache with their
+	// journal records.
+	mu    sync.Mutex
+	cache *rrcache.Cache[dms.FfprobeCacheKey, *ffprobe.Info]
+	file  *os.File
+	w     *bufio.Writer
+	// Records in the journal, including superseded ones.
+	journalLen int
+	err        error
+
+	closed  chan struct{}
+	stopped chan struct{}
+}
+
+var _ dms.Cache = (*Store)(nil)
+
+// Open loads the store at path, creating it if it doesn't exist. Entries for
+// files that no longer exist are pruned.
+func Open(path string, opts Options) (s *Store, err error) {
+	if opts.Capacity <= 0 {
+		opts.Capacity = DefaultCapacity
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DefaultFlushInterval
+	}
+	if opts.PruneInterval == 0 {
+		opts.PruneInterval = DefaultPruneInterval
+	}
+	s = &Store{
+		path:    path,
+		opts:    opts,
+		closed:  make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	s.cache = rrcache.NewCache(int64(opts.Capacity), rrcache.Options[dms.FfprobeCacheKey, *ffprobe.Info]{
+		Policy:  rrcache.LRU,
+		OnEvict: s.evicted,
+	})
+	if err = s.load(); err != nil && !os.IsNotExist(err) {
+		log.Printf("error loading probe cache %q: %s", path, err)
+	}
+	pruned := s.prune()
+	s.mu.Lock()
+	err = s.compact()
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("loaded %d items from probe cache, pruned %d", s.Len(), pruned)
+	go s.run()
+	return
+}
+
+// Replays the journal. The legacy format, a JSON array of items written at
+// exit, is also accepted.
+func (s *Store) load() error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	if b, err := r.Peek(1); err == nil && bytes.Equal(b, []byte("[")) {
+		var items []dms.FfprobeCacheItem
+		if err := json.NewDecoder(r).Decode(&items); err != nil {
+			return err
+		}
+		for i := range items {
+			s.cache.Set(items[i].Key, items[i].Value, 1)
+		}
+		return nil
+	}
+	dec := json.NewDecoder(r)
+	for {
+		var rec record
+		err := dec.Decode(&rec)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// Most likely a record torn by a crash. Everything before it is
+			// kept, and compaction will drop the remainder.
+			return fmt.Errorf("journal record %d: %s", s.journalLen+1, err)
+		}
+		s.journalLen++
+		if rec.Delete {
+			s.cache.Delete(rec.Key)
+		} else {
+			s.cache.Set(rec.Key, rec.Value, 1)
+		}
+	}
+}
+
+func (s *Store) run() {
+	defer close(s.stopped)
+	flush := time.NewTicker(s.opts.FlushInterval)
+	defer flush.Stop()
+	var prune <-chan time.Time
+	if s.opts.PruneInterval > 0 {
+		t := time.NewTicker(s.opts.PruneInterval)
+		defer t.Stop()
+		prune = t.C
+	}
+	for {
+		select {
+		case <-flush.C:
+			if err := s.Flush(); err != nil {
+				log.Printf("error flushing probe cache: %s", err)
+			}
+		case <-prune:
+			if n := s.prune(); n != 0 {
+				log.Printf("pruned %d items from probe cache", n)
+			}
+		case <-s.closed:
+			return
+		}
+	}
+}
+
+// Get returns the probe result for a dms.FfprobeCacheKey.
+func (s *Store) Get(key interface{}) (value interface{}, ok bool) {
+	k, ok := key.(dms.FfprobeCacheKey)
+	if !ok {
+		return
+	}
+	value, ok = s.cache.Get(k)
+	return
+}
+
+// Set stores a probe result, which may be nil, for a dms.FfprobeCacheKey.
+// Other types are ignored.
+func (s *Store) Set(key interface{}, value interface{}) {
+	k, ok := key.(dms.FfprobeCacheKey)
+	if !ok {
+		log.Printf("probe cache: unexpected key type %T", key)
+		return
+	}
+	info, _ := value.(*ffprobe.Info)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.append(record{FfprobeCacheItem: dms.FfprobeCacheItem{Key: k, Value: info}})
+	s.cache.Set(k, info, 1)
+	s.maybeCompact()
+}
+
+// Len returns the number of entries in the store.
+func (s *Store) Len() int {
+	return s.cache.Len()
+}
+
+// Stats returns the hit and miss counts et al. of the store.
+func (s *Store) Stats() rrcache.Stats {
+	return s.cache.Stats()
+}
+
+// Journals an entry evicted by the cache. The store's lock is held by
+// whatever caused the eviction.
+func (s *Store) evicted(key dms.FfprobeCacheKey, _ *ffprobe.Info) {
+	s.deleted(key)
+}
+
+func (s *Store) deleted(key dms.FfprobeCacheKey) {
+	s.append(record{
+		FfprobeCacheItem: dms.FfprobeCacheItem{Key: key},
+		Delete:           true,
+	})
+}
+
+// Removes entries whose files no longer exist or have been modified since
+// they were probed. Returns the number of entries removed.
+func (s *Store) prune() (n int) {
+	// Stat without holding the lock, the files may be on slow storage.
+	var stale []dms.FfprobeCacheKey
+	for _, item := range s.cache.Items() {
+		k := item.Key
+		fi, err := os.Stat(k.Path)
+		if err == nil && fi.ModTime().UnixNano() == k.ModTime {
+			continue
+		}
+		if err != nil && !os.IsNotExist(err) {
+			continue
+		}
+		stale = append(stale, k)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range stale {
+		if s.cache.Delete(k) {
+			s.deleted(k)
+			n++
+		}
+	}
+	s.maybeCompact()
+	return
+}
+
+// Appends a record to the journal buffer. Errors are retained and returned
+// by the next Flush.
+func (s *Store) append(rec record) {
+	if s.w == nil {
+		return
+	}
+	b, err := json.Marshal(rec)
+	if err != nil {
+		log.Printf("could not marshal probe cache record: %s", err)
+		return
+	}
+	b = append(b, '\n')
+	if _, err := s.w.Write(b); err != nil && s.err == nil {
+		s.err = err
+	}
+	s.journalLen++
+}
+
+func (s *Store) maybeCompact() {
+	if s.journalLen <= 2*s.cache.Len()+compactSlack {
+		return
+	}
+	if err := s.compact(); err != nil {
+		log.Printf("error compacting probe cache: %s", err)
+	}
+}
+
+// Rewrites the journal with only the live entries, least recently used
+// first, so that replaying it restores the recency order.
+func (s *Store) compact() (err error) {
+	f, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path))
+	if err != nil {
+		return
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	n := 0
+	for _, item := range s.cache.Items() {
+		if err = enc.Encode(record{FfprobeCacheItem: dms.FfprobeCacheItem{Key: item.Key, Value: item.Value}}); err != nil {
+			break
+		}
+		n++
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return
+	}
+	if s.file != nil {
+		s.file.Close()
+		s.file = nil
+		s.w = nil
+	}
+	if runtime.GOOS == "windows" {
+		if err = os.Remove(s.path); os.IsNotExist(err) {
+			err = nil
+		}
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.path)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return
+	}
+	s.file = f
+	s.w = bufio.NewWriter(f)
+	s.journalLen = n
+	s.err = nil
+	return
+}
+
+// Flush writes buffered journal records to disk.
+func (s *Store) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.flush()
+}
+
+func (s *Store) flush() error {
+	if s.w == nil {
+		return s.err
+	}
+	if s.w.Buffered() == 0 {
+		return s.err
+	}
+	if err := s.w.Flush(); err != nil && s.err == nil {
+		s.err = err
+	}
+	if err := s.file.Sync(); err != nil && s.err == nil {
+		s.err = err
+	}
+	err := s.err
+	if err != nil {
+		// Start afresh, the journal may have a torn record at its end.
+		if err := s.compact(); err != nil {
+			log.Printf("error compacting probe cache: %s", err)
+		}
+	}
+	return err
+}
+
+// Close flushes the store and closes the journal. The store must not be used
+// afterwards.
+func (s *Store) Close() error {
+	close(s.closed)
+	<-s.stopped
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.flush()
+	if s.file != nil {
+		if cerr := s.file.Close(); err == nil {
+			err = cerr
+		}
+		s.file = nil
+		s.w = nil
+	}
+	return err
+}
@@ -0,0 +1,168 @@
+package probecache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/anacrolix/dms/dlna/dms"
+	"github.com/anacrolix/ffprobe"
+)
+
+// Creates a file in dir and returns its cache key.
+func mediaKey(t *testing.T, dir, name string) dms.FfprobeCacheKey {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dms.FfprobeCacheKey{Path: path, ModTime: fi.ModTime().UnixNano()}
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "probecache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestPersistsAcrossReopen(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	storePath := filepath.Join(dir, "cache")
+	a := mediaKey(t, dir, "a.mkv")
+	b := mediaKey(t, dir, "b.mkv")
+	s, err := Open(storePath, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Set(a, &ffprobe.Info{Format: map[string]interface{}{"duration": "1.0"}})
+	s.Set(b, (*ffprobe.Info)(nil))
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	// Simulate a crash: the store is reopened without being closed.
+	s, err = Open(storePath, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	v, ok := s.Get(a)
+	if !ok {
+		t.Fatal("missing entry after reopen")
+	}
+	if v.(*ffprobe.Info).Format["duration"] != "1.0" {
+		t.Fatalf("unexpected value %#v", v)
+	}
+	v, ok = s.Get(b)
+	if !ok || v.(*ffprobe.Info) != nil {
+		t.Fatalf("expected cached nil result, got %#v, %v", v, ok)
+	}
+}
+
+func TestEvictsLeastRecentlyUsed(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	a := mediaKey(t, dir, "a.mkv")
+	b := mediaKey(t, dir, "b.mkv")
+	c := mediaKey(t, dir, "c.mkv")
+	s, err := Open(filepath.Join(dir, "cache"), Options{Capacity: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	s.Set(a, nil)
+	s.Set(b, nil)
+	s.Get(a)
+	s.Set(c, nil)
+	if _, ok := s.Get(b); ok {
+		t.Fatal("least recently used entry wasn't evicted")
+	}
+	for _, k := range []dms.FfprobeCacheKey{a, c} {
+		if _, ok := s.Get(k); !ok {
+			t.Fatalf("%s was evicted", k.Path)
+		}
+	}
+}
+
+func TestPrunesRemovedFiles(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	storePath := filepath.Join(dir, "cache")
+	a := mediaKey(t, dir, "a.mkv")
+	b := mediaKey(t, dir, "b.mkv")
+	s, err := Open(storePath, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Set(a, nil)
+	s.Set(b, nil)
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	os.Remove(b.Path)
+	s, err = Open(storePath, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if s.Len() != 1 {
+		t.Fatalf("expected 1 entry, got %d", s.Len())
+	}
+	if _, ok := s.Get(b); ok {
+		t.Fatal("entry for removed file wasn't pruned")
+	}
+}
+
+func TestTornJournal(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	storePath := filepath.Join(dir, "cache")
+	a := mediaKey(t, dir, "a.mkv")
+	s, err := Open(storePath, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Set(a, nil)
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(storePath, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"Key":{"Path":"/x","ModTi`)
+	f.Close()
+	s, err = Open(storePath, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if _, ok := s.Get(a); !ok {
+		t.Fatal("records before the torn one were lost")
+	}
+}
+
+func TestLegacyFormat(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	storePath := filepath.Join(dir, "cache")
+	a := mediaKey(t, dir, "a.mkv")
+	if err := ioutil.WriteFile(storePath, []byte(`[{"Key":{"Path":"`+a.Path+`","ModTime":`+strconv.FormatInt(a.ModTime, 10)+`},"Value":null}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := Open(storePath, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if _, ok := s.Get(a); !ok {
+		t.Fatal("legacy item wasn't loaded")
+	}
+}
@@ -0,0 +1,205 @@
+package rrcache
+
+import (
+	"container/list"
+	"math/rand"
+)
+
+type randomPolicy[K comparable] struct {
+	slice []K
+	index map[K]int
+}
+
+func newRandom[K comparable]() *randomPolicy[K] {
+	return &randomPolicy[K]{index: make(map[K]int)}
+}
+
+func (p *randomPolicy[K]) add(key K) {
+	p.index[key] = len(p.slice)
+	p.slice = append(p.slice, key)
+}
+
+func (p *randomPolicy[K]) touch(K) {}
+
+func (p *randomPolicy[K]) remove(key K) {
+	i := p.index[key]
+	last := p.slice[len(p.slice)-1]
+	p.slice[i] = last
+	p.index[last] = i
+	p.slice = p.slice[:len(p.slice)-1]
+	delete(p.index, key)
+}
+
+func (p *randomPolicy[K]) victim() K {
+	key := p.slice[rand.Intn(len(p.slice))]
+	p.remove(key)
+	return key
+}
+
+func (p *randomPolicy[K]) keys() []K {
+	return append([]K(nil), p.slice...)
+}
+
+// Keys ordered by recency of use, most recent at the front.
+type recencyList[K comparable] struct {
+	l     *list.List
+	elems map[K]*list.Element
+}
+
+func newRecencyList[K comparable]() recencyList[K] {
+	return recencyList[K]{list.New(), make(map[K]*list.Element)}
+}
+
+func (r *recencyList[K]) contains(key K) bool {
+	_, ok := r.elems[key]
+	return ok
+}
+
+func (r *recencyList[K]) pushFront(key K) {
+	r.elems[key] = r.l.PushFront(key)
+}
+
+func (r *recencyList[K]) moveToFront(key K) {
+	r.l.MoveToFront(r.elems[key])
+}
+
+func (r *recencyList[K]) remove(key K) bool {
+	e, ok := r.elems[key]
+	if ok {
+		r.l.Remove(e)
+		delete(r.elems, key)
+	}
+	return ok
+}
+
+func (r *recencyList[K]) popBack() K {
+	key := r.l.Back().Value.(K)
+	r.remove(key)
+	return key
+}
+
+func (r *recencyList[K]) len() int {
+	return r.l.Len()
+}
+
+// Appends keys from least to most recently used.
+func (r *recencyList[K]) appendKeys(keys []K) []K {
+	for e := r.l.Back(); e != nil; e = e.Prev() {
+		keys = append(keys, e.Value.(K))
+	}
+	return keys
+}
+
+type lruPolicy[K comparable] struct {
+	recencyList[K]
+}
+
+func newLRU[K comparable]() *lruPolicy[K] {
+	return &lruPolicy[K]{newRecencyList[K]()}
+}
+
+func (p *lruPolicy[K]) add(key K) {
+	p.pushFront(key)
+}
+
+func (p *lruPolicy[K]) touch(key K) {
+	p.moveToFront(key)
+}
+
+func (p *lruPolicy[K]) remove(key K) {
+	p.recencyList.remove(key)
+}
+
+func (p *lruPolicy[K]) victim() K {
+	return p.popBack()
+}
+
+func (p *lruPolicy[K]) keys() []K {
+	return p.appendKeys(nil)
+}
+
+// Adaptive replacement, after Megiddo and Modha. t1 holds keys used once
+// recently, t2 keys used at least twice. b1 and b2 are ghosts of keys evicted
+// from t1 and t2, and steer the target size of t1, p. Sizes are counted in
+// items rather than the sizes given to Set.
+type arcPolicy[K comparable] struct {
+	t1, t2, b1, b2 recencyList[K]
+	p              int
+}
+
+func newARC[K comparable]() *arcPolicy[K] {
+	return &arcPolicy[K]{
+		t1: newRecencyList[K](),
+		t2: newRecencyList[K](),
+		b1: newRecencyList[K](),
+		b2: newRecencyList[K](),
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (p *arcPolicy[K]) add(key K) {
+	c := p.t1.len() + p.t2.len() + 1
+	switch {
+	case p.b1.remove(key):
+		// Evicted for lack of recency: favour t1.
+		p.p = minInt(p.p+maxInt(p.b2.len()/maxInt(p.b1.len(), 1), 1), c)
+		p.t2.pushFront(key)
+	case p.b2.remove(key):
+		// Evicted for lack of frequency: favour t2.
+		p.p = maxInt(p.p-maxInt(p.b1.len()/maxInt(p.b2.len(), 1), 1), 0)
+		p.t2.pushFront(key)
+	default:
+		p.t1.pushFront(key)
+	}
+	// Keep the ghosts no larger than the live lists.
+	for p.b1.len()+p.b2.len() > c {
+		if p.b1.len() > p.b2.len() {
+			p.b1.popBack()
+		} else {
+			p.b2.popBack()
+		}
+	}
+}
+
+func (p *arcPolicy[K]) touch(key K) {
+	if p.t1.remove(key) {
+		p.t2.pushFront(key)
+		return
+	}
+	p.t2.moveToFront(key)
+}
+
+func (p *arcPolicy[K]) remove(key K) {
+	if !p.t1.remove(key) {
+		p.t2.remove(key)
+	}
+}
+
+func (p *arcPolicy[K]) victim() K {
+	if p.t1.len() != 0 && (p.t1.len() > p.p || p.t2.len() == 0) {
+		key := p.t1.popBack()
+		p.b1.pushFront(key)
+		return key
+	}
+	key := p.t2.popBack()
+	p.b2.pushFront(key)
+	return key
+}
+
+func (p *arcPolicy[K]) keys() []K {
+	keys := p.t1.appendKeys(nil)
+	return p.t2.appendKeys(keys)
+}
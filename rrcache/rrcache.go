@@ -1,79 +1,235 @@
-// Package rrcache implements a random replacement cache. Items are set with
-// an associated size. When the capacity is exceeded, items will be randomly
-// evicted until it is not.
+// Package rrcache implements size bounded caches. Items are set with an
+// associated size. When the capacity is exceeded, items are evicted according
+// to the cache's policy until it is not. Random replacement, LRU and ARC
+// policies are available. Caches are safe for concurrent use.
 package rrcache
 
 import (
-	"math/rand"
+	"sync"
+	"time"
 )
 
-type RRCache struct {
+// Policy selects the items evicted when a cache is over capacity.
+type Policy int
+
+const (
+	// Evict random items.
+	Random Policy = iota
+	// Evict the least recently used items.
+	LRU
+	// Adaptive replacement cache. Balances recency and frequency of use.
+	ARC
+)
+
+type Options[K comparable, V any] struct {
+	Policy Policy
+	// Default time to live for items. Zero means items don't expire.
+	TTL time.Duration
+	// Called with items that are evicted or expire, but not those that are
+	// replaced or deleted. It's called with the cache locked, and mustn't use
+	// the cache.
+	OnEvict func(key K, value V)
+}
+
+// Stats counts cache operations since it was created.
+type Stats struct {
+	Hits        int64
+	Misses      int64
+	Sets        int64
+	Deletes     int64
+	Evictions   int64
+	Expirations int64
+}
+
+type Cache[K comparable, V any] struct {
+	mu       sync.Mutex
 	capacity int64
 	size     int64
+	ttl      time.Duration
+	onEvict  func(key K, value V)
+	policy   policy[K]
+	table    map[K]*entry[V]
+	stats    Stats
+	now      func() time.Time
+}
 
-	keys  []interface{}
-	table map[interface{}]*entry
+type entry[V any] struct {
+	size    int64
+	value   V
+	expires time.Time
 }
 
-type entry struct {
-	size  int64
-	value interface{}
+// Tracks the keys in a cache to determine eviction order.
+type policy[K comparable] interface {
+	// A key was added to the cache.
+	add(K)
+	// An existing key was used.
+	touch(K)
+	// A key was removed for reasons other than eviction.
+	remove(K)
+	// Removes and returns the key to evict next.
+	victim() K
+	// Keys in the order they would be evicted.
+	keys() []K
 }
 
-func New(capacity int64) *RRCache {
-	return &RRCache{
+func newPolicy[K comparable](p Policy) policy[K] {
+	switch p {
+	case LRU:
+		return newLRU[K]()
+	case ARC:
+		return newARC[K]()
+	default:
+		return newRandom[K]()
+	}
+}
+
+// NewCache returns an empty cache that holds items with a total size of up to
+// capacity.
+func NewCache[K comparable, V any](capacity int64, opts Options[K, V]) *Cache[K, V] {
+	return &Cache[K, V]{
 		capacity: capacity,
-		table:    make(map[interface{}]*entry),
+		ttl:      opts.TTL,
+		onEvict:  opts.OnEvict,
+		policy:   newPolicy[K](opts.Policy),
+		table:    make(map[K]*entry[V]),
+		now:      time.Now,
 	}
 }
 
+// RRCache is the original untyped random replacement cache.
+type RRCache = Cache[interface{}, interface{}]
+
+// New returns an untyped random replacement cache.
+func New(capacity int64) *RRCache {
+	return NewCache(capacity, Options[interface{}, interface{}]{Policy: Random})
+}
+
 // Returns the sum size of all items currently in the cache.
-func (c *RRCache) Size() int64 {
+func (c *Cache[K, V]) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.size
 }
 
-func (c *RRCache) Set(key interface{}, value interface{}, size int64) {
+// Len returns the number of items in the cache, including expired items that
+// haven't been removed yet.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.table)
+}
+
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Set adds or replaces an item using the cache's default TTL.
+func (c *Cache[K, V]) Set(key K, value V, size int64) {
+	c.SetWithTTL(key, value, size, c.ttl)
+}
+
+// SetWithTTL adds or replaces an item that expires after ttl. Zero ttl means
+// the item doesn't expire. Items larger than the capacity aren't stored.
+func (c *Cache[K, V]) SetWithTTL(key K, value V, size int64, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	if size > c.capacity {
+		c.delete(key)
 		return
 	}
+	c.stats.Sets++
 	_entry := c.table[key]
 	if _entry == nil {
-		_entry = new(entry)
-		c.keys = append(c.keys, key)
+		_entry = new(entry[V])
 		c.table[key] = _entry
+		c.policy.add(key)
+	} else {
+		c.policy.touch(key)
 	}
-	sizeDelta := size - _entry.size
+	c.size += size - _entry.size
 	_entry.value = value
 	_entry.size = size
-	c.size += sizeDelta
+	if ttl > 0 {
+		_entry.expires = c.now().Add(ttl)
+	} else {
+		_entry.expires = time.Time{}
+	}
 	for c.size > c.capacity {
-		i := rand.Intn(len(c.keys))
-		key := c.keys[i]
-		c.keys[i] = c.keys[len(c.keys)-1]
-		c.keys = c.keys[:len(c.keys)-1]
-		c.size -= c.table[key].size
-		delete(c.table, key)
+		key := c.policy.victim()
+		c.stats.Evictions++
+		c.drop(key)
+	}
+}
+
+// Removes an item that's already been removed from the policy.
+func (c *Cache[K, V]) drop(key K) {
+	e := c.table[key]
+	c.size -= e.size
+	delete(c.table, key)
+	if c.onEvict != nil {
+		c.onEvict(key, e.value)
 	}
 }
 
-func (c *RRCache) Get(key interface{}) (value interface{}, ok bool) {
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	entry, ok := c.table[key]
+	if ok && !entry.expires.IsZero() && !c.now().Before(entry.expires) {
+		c.policy.remove(key)
+		c.stats.Expirations++
+		c.drop(key)
+		ok = false
+	}
 	if !ok {
+		c.stats.Misses++
 		return
 	}
+	c.stats.Hits++
+	c.policy.touch(key)
 	value = entry.value
 	return
 }
 
-type Item struct {
-	Key, Value interface{}
+// Delete removes an item, and returns whether it was present.
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.delete(key)
+}
+
+func (c *Cache[K, V]) delete(key K) bool {
+	e, ok := c.table[key]
+	if !ok {
+		return false
+	}
+	c.stats.Deletes++
+	c.policy.remove(key)
+	c.size -= e.size
+	delete(c.table, key)
+	return true
+}
+
+type Item[K comparable, V any] struct {
+	Key   K
+	Value V
 }
 
-// Return all items currently in the cache. This is made available for
-// serialization purposes.
-func (c *RRCache) Items() (itens []Item) {
-	for k, e := range c.table {
-		itens = append(itens, Item{k, e.value})
+// Return all unexpired items currently in the cache, in the order they would
+// be evicted. This is made available for serialization purposes.
+func (c *Cache[K, V]) Items() (items []Item[K, V]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for _, k := range c.policy.keys() {
+		e := c.table[k]
+		if !e.expires.IsZero() && !now.Before(e.expires) {
+			continue
+		}
+		items = append(items, Item[K, V]{k, e.value})
 	}
 	return
 }
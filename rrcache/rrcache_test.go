@@ -0,0 +1,104 @@
+package rrcache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCapacity(t *testing.T) {
+	for _, p := range []Policy{Random, LRU, ARC} {
+		c := NewCache(10, Options[int, string]{Policy: p})
+		for i := 0; i < 100; i++ {
+			c.Set(i, "", 3)
+			if c.Size() > 10 {
+				t.Fatalf("policy %d: size %d exceeds capacity", p, c.Size())
+			}
+		}
+		if c.Len() != 3 {
+			t.Fatalf("policy %d: expected 3 items, got %d", p, c.Len())
+		}
+		if len(c.Items()) != c.Len() {
+			t.Fatalf("policy %d: items and length differ", p)
+		}
+	}
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewCache(2, Options[string, int]{Policy: LRU})
+	c.Set("a", 1, 1)
+	c.Set("b", 2, 1)
+	c.Get("a")
+	c.Set("c", 3, 1)
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("b should have been evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatal("a should have been kept")
+	}
+	items := c.Items()
+	if items[0].Key != "c" || items[1].Key != "a" {
+		t.Fatalf("unexpected eviction order %v", items)
+	}
+}
+
+func TestARCKeepsFrequentlyUsed(t *testing.T) {
+	c := NewCache(3, Options[int, int]{Policy: ARC})
+	c.Set(0, 0, 1)
+	c.Get(0)
+	// A scan of items used once shouldn't push out the frequently used one.
+	for i := 1; i < 20; i++ {
+		c.Set(i, i, 1)
+	}
+	if _, ok := c.Get(0); !ok {
+		t.Fatal("frequently used item was evicted by a scan")
+	}
+}
+
+func TestDeleteAndStats(t *testing.T) {
+	var evicted []string
+	c := NewCache(1, Options[string, int]{
+		Policy:  LRU,
+		OnEvict: func(k string, _ int) { evicted = append(evicted, k) },
+	})
+	c.Set("a", 1, 1)
+	c.Get("a")
+	c.Get("b")
+	if !c.Delete("a") || c.Delete("a") {
+		t.Fatal("unexpected Delete result")
+	}
+	c.Set("b", 2, 1)
+	c.Set("c", 3, 1)
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Fatalf("unexpected evictions %v", evicted)
+	}
+	s := c.Stats()
+	if s.Hits != 1 || s.Misses != 1 || s.Sets != 3 || s.Deletes != 1 || s.Evictions != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
+
+func TestTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := NewCache(10, Options[string, int]{TTL: time.Minute})
+	c.now = func() time.Time { return now }
+	c.Set("a", 1, 1)
+	c.SetWithTTL("b", 2, 1, 0)
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("a should have expired")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatal("b shouldn't expire")
+	}
+	if c.Stats().Expirations != 1 || c.Size() != 1 {
+		t.Fatalf("unexpected state %+v, size %d", c.Stats(), c.Size())
+	}
+}
+
+func TestUntyped(t *testing.T) {
+	c := New(1)
+	c.Set("a", 1, 1)
+	if v, ok := c.Get("a"); !ok || v.(int) != 1 {
+		t.Fatal(v, ok)
+	}
+}
@@ -0,0 +1,75 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// Limits the size of responses read by Call.
+const maxResponseSize = 16 << 20
+
+// Client calls actions on services of other devices.
+type Client struct {
+	// Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Sent as the USER-AGENT, if not empty.
+	UserAgent string
+}
+
+var DefaultClient = &Client{}
+
+// Call invokes an action on the service at controlURL with the arguments in
+// order, and returns the response arguments in the order they were sent.
+// Faults are returned as *UPnPError or *FaultError.
+func Call(ctx context.Context, controlURL, serviceURN, action string, args []Arg) ([]Arg, error) {
+	return DefaultClient.Call(ctx, controlURL, serviceURN, action, args)
+}
+
+func (c *Client) Call(ctx context.Context, controlURL, serviceURN, action string, args []Arg) ([]Arg, error) {
+	reqBody := MarshalEnvelope(MarshalAction(serviceURN, action, args))
+	req, err := http.NewRequest("POST", controlURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", `text/xml; charset="utf-8"`)
+	req.Header.Set("SOAPACTION", fmt.Sprintf(`"%s#%s"`, serviceURN, action))
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	body, envErr := ReadEnvelope(bytes.NewReader(respBody))
+	if envErr == nil && body.Fault != nil {
+		return nil, body.Fault.Err()
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status %q", action, resp.Status)
+	}
+	if envErr != nil {
+		return nil, fmt.Errorf("%s: bad response envelope: %s", action, envErr)
+	}
+	a, err := UnmarshalAction(body.Action)
+	if err != nil {
+		return nil, fmt.Errorf("%s: bad response: %s", action, err)
+	}
+	if a.XMLName.Local != action+"Response" {
+		return nil, fmt.Errorf("%s: unexpected response element %s", action, a.XMLName.Local)
+	}
+	return a.Args, nil
+}
@@ -0,0 +1,104 @@
+package soap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Serves a single action, checking the request is well formed.
+func testActionServer(t *testing.T, handle func(a Action) (status int, body []byte)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("method %s", r.Method)
+		}
+		body, err := ReadEnvelope(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		a, err := UnmarshalAction(body.Action)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if want := `"` + a.XMLName.Space + "#" + a.XMLName.Local + `"`; r.Header.Get("SOAPACTION") != want {
+			t.Errorf("SOAPACTION %q, want %q", r.Header.Get("SOAPACTION"), want)
+		}
+		status, resp := handle(a)
+		w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+		w.WriteHeader(status)
+		w.Write(resp)
+	}))
+}
+
+func TestCall(t *testing.T) {
+	s := testActionServer(t, func(a Action) (int, []byte) {
+		if id, _ := a.Arg("InstanceID"); id != "0" {
+			t.Errorf("InstanceID %q", id)
+		}
+		return 200, MarshalEnvelope(MarshalAction(a.XMLName.Space, a.XMLName.Local+"Response", []Arg{
+			NewArg("Track", "1"),
+			NewArg("TrackDuration", "0:03:00"),
+			NewArg("RelTime", "0:00:10"),
+		}))
+	})
+	defer s.Close()
+	out, err := Call(context.Background(), s.URL, testServiceURN, "GetPositionInfo", []Arg{NewArg("InstanceID", "0")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, arg := range out {
+		names = append(names, arg.XMLName.Local)
+	}
+	if len(names) != 3 || names[0] != "Track" || names[2] != "RelTime" || out[1].Value != "0:03:00" {
+		t.Fatal(out)
+	}
+}
+
+func TestCallUPnPError(t *testing.T) {
+	s := testActionServer(t, func(a Action) (int, []byte) {
+		return 500, MarshalEnvelope(MarshalUPnPFault(701, "Transition not available"))
+	})
+	defer s.Close()
+	_, err := Call(context.Background(), s.URL, testServiceURN, "Play", []Arg{NewArg("InstanceID", "0"), NewArg("Speed", "1")})
+	ue, ok := err.(*UPnPError)
+	if !ok || ue.Code != 701 {
+		t.Fatalf("%#v", err)
+	}
+}
+
+func TestCallHTTPError(t *testing.T) {
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+	if _, err := Call(context.Background(), s.URL, testServiceURN, "Stop", nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCallWrongResponse(t *testing.T) {
+	s := testActionServer(t, func(a Action) (int, []byte) {
+		return 200, MarshalEnvelope(MarshalAction(a.XMLName.Space, "PauseResponse", nil))
+	})
+	defer s.Close()
+	if _, err := Call(context.Background(), s.URL, testServiceURN, "Stop", nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCallContext(t *testing.T) {
+	block := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	defer s.Close()
+	defer close(block)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := Call(ctx, s.URL, testServiceURN, "Stop", nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
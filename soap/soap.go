@@ -1,12 +1,18 @@
 package soap
 
 import (
+	"bytes"
 	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
 )
 
 const (
 	EncodingStyle = "http://schemas.xmlsoap.org/soap/encoding/"
 	EnvelopeNS    = "http://schemas.xmlsoap.org/soap/envelope/"
+	// The namespace of UPnPError fault details.
+	ControlNS = "urn:schemas-upnp-org:control-1-0"
 )
 
 type Arg struct {
@@ -14,13 +20,48 @@ type Arg struct {
 	Value   string `xml:",chardata"`
 }
 
+func NewArg(name, value string) Arg {
+	return Arg{XMLName: xml.Name{Local: name}, Value: value}
+}
+
+// An action request or response. Args are in document order.
 type Action struct {
 	XMLName xml.Name
-	Args    []Arg
+	Args    []Arg `xml:",any"`
+}
+
+// Returns the value of the named argument.
+func (a *Action) Arg(name string) (value string, ok bool) {
+	for _, arg := range a.Args {
+		if arg.XMLName.Local == name {
+			return arg.Value, true
+		}
+	}
+	return
 }
 
 type Body struct {
+	// The raw content, normally an action.
 	Action []byte `xml:",innerxml"`
+	// Set if the body is a fault.
+	Fault *Fault `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault"`
+}
+
+type Fault struct {
+	FaultCode   string `xml:"faultcode"`
+	FaultString string `xml:"faultstring"`
+	Detail      struct {
+		UPnPError *UPnPError
+	} `xml:"detail"`
+}
+
+// Err returns the fault's UPnPError if it has one, and a *FaultError
+// otherwise.
+func (f *Fault) Err() error {
+	if f.Detail.UPnPError != nil {
+		return f.Detail.UPnPError
+	}
+	return &FaultError{f.FaultCode, f.FaultString}
 }
 
 type UPnPError struct {
@@ -29,26 +70,18 @@ type UPnPError struct {
 	Desc    string   `xml:"errorDescription"`
 }
 
-type FaultDetail struct {
-	XMLName xml.Name `xml:"detail"`
-	Data    interface{}
+func (e *UPnPError) Error() string {
+	return fmt.Sprintf("UPnP error %d: %s", e.Code, e.Desc)
 }
 
-type Fault struct {
-	XMLName     xml.Name    `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault"`
-	FaultCode   string      `xml:"faultcode"`
-	FaultString string      `xml:"faultstring"`
-	Detail      FaultDetail `xml:"detail"`
-}
-
-func NewFault(s string, detail interface{}) *Fault {
-	return &Fault{
-		FaultCode:   EnvelopeNS + ":Client",
-		FaultString: s,
-		Detail: FaultDetail{
-			Data: detail,
-		},
-	}
+// A fault that isn't a UPnPError.
+type FaultError struct {
+	Code   string
+	String string
+}
+
+func (e *FaultError) Error() string {
+	return fmt.Sprintf("SOAP fault %s: %s", e.Code, e.String)
 }
 
 type Envelope struct {
@@ -57,12 +90,64 @@ type Envelope struct {
 	Body          Body     `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
 }
 
-/* XML marshalling of nested namespaces is broken.
+// encoding/xml can't marshal namespace prefixes, which some devices insist
+// on, so envelopes are written by hand.
+
+func escape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// MarshalEnvelope returns an envelope document around the given body
+// content.
+func MarshalEnvelope(body []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="utf-8" standalone="yes"?>`)
+	buf.WriteString(`<s:Envelope xmlns:s="` + EnvelopeNS + `" s:encodingStyle="` + EncodingStyle + `">`)
+	buf.WriteString(`<s:Body>`)
+	buf.Write(body)
+	buf.WriteString(`</s:Body></s:Envelope>`)
+	return buf.Bytes()
+}
 
-func NewEnvelope(action []byte) Envelope {
-	return Envelope{
-		EncodingStyle: EncodingStyle,
-		Body:          Body{action},
+// MarshalAction returns an action element in the service's namespace, with
+// the arguments in the order given. Responses are actions named with a
+// "Response" suffix.
+func MarshalAction(serviceURN, name string, args []Arg) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<u:%s xmlns:u="%s">`, name, escape(serviceURN))
+	for _, arg := range args {
+		fmt.Fprintf(&buf, "<%[1]s>%[2]s</%[1]s>", arg.XMLName.Local, escape(arg.Value))
 	}
+	fmt.Fprintf(&buf, `</u:%s>`, name)
+	return buf.Bytes()
+}
+
+// MarshalUPnPFault returns a fault element carrying a UPnPError, as sent in
+// response to failed actions.
+func MarshalUPnPFault(code uint, desc string) []byte {
+	return []byte(`<s:Fault>` +
+		`<faultcode>s:Client</faultcode>` +
+		`<faultstring>UPnPError</faultstring>` +
+		`<detail>` +
+		`<UPnPError xmlns="` + ControlNS + `">` +
+		`<errorCode>` + strconv.FormatUint(uint64(code), 10) + `</errorCode>` +
+		`<errorDescription>` + escape(desc) + `</errorDescription>` +
+		`</UPnPError>` +
+		`</detail>` +
+		`</s:Fault>`)
+}
+
+// ReadEnvelope decodes an envelope, returning its body.
+func ReadEnvelope(r io.Reader) (body Body, err error) {
+	var env Envelope
+	err = xml.NewDecoder(r).Decode(&env)
+	return env.Body, err
+}
+
+// UnmarshalAction decodes an action element from envelope body content.
+func UnmarshalAction(body []byte) (a Action, err error) {
+	err = xml.Unmarshal(body, &a)
+	return
 }
-*/
@@ -0,0 +1,93 @@
+package soap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+const testServiceURN = "urn:schemas-upnp-org:service:AVTransport:1"
+
+func TestActionRoundTrip(t *testing.T) {
+	args := []Arg{
+		NewArg("InstanceID", "0"),
+		NewArg("CurrentURI", "http://host/a?b=1&c=<2>"),
+		NewArg("CurrentURIMetaData", ""),
+	}
+	doc := MarshalEnvelope(MarshalAction(testServiceURN, "SetAVTransportURI", args))
+	var env Envelope
+	if err := xml.Unmarshal(doc, &env); err != nil {
+		t.Fatal(err)
+	}
+	if env.EncodingStyle != EncodingStyle {
+		t.Fatalf("encoding style %q", env.EncodingStyle)
+	}
+	body, err := ReadEnvelope(bytes.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body.Fault != nil {
+		t.Fatal("unexpected fault")
+	}
+	a, err := UnmarshalAction(body.Action)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.XMLName != (xml.Name{Space: testServiceURN, Local: "SetAVTransportURI"}) {
+		t.Fatal(a.XMLName)
+	}
+	if len(a.Args) != len(args) {
+		t.Fatal(a.Args)
+	}
+	for i, arg := range a.Args {
+		if arg.XMLName.Local != args[i].XMLName.Local || arg.Value != args[i].Value {
+			t.Fatalf("arg %d: got %v, want %v", i, arg, args[i])
+		}
+	}
+	if v, ok := a.Arg("CurrentURI"); !ok || v != args[1].Value {
+		t.Fatal(v, ok)
+	}
+}
+
+func TestUPnPFaultRoundTrip(t *testing.T) {
+	doc := MarshalEnvelope(MarshalUPnPFault(718, "Invalid <InstanceID>"))
+	body, err := ReadEnvelope(bytes.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body.Fault == nil {
+		t.Fatal("fault not decoded")
+	}
+	if body.Fault.FaultCode != "s:Client" || body.Fault.FaultString != "UPnPError" {
+		t.Fatalf("%+v", body.Fault)
+	}
+	want := &UPnPError{
+		XMLName: xml.Name{Space: ControlNS, Local: "UPnPError"},
+		Code:    718,
+		Desc:    "Invalid <InstanceID>",
+	}
+	if err := body.Fault.Err(); !reflect.DeepEqual(err, want) {
+		t.Fatalf("got %#v", err)
+	}
+}
+
+func TestPlainFault(t *testing.T) {
+	doc := `<?xml version="1.0"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+ <SOAP-ENV:Body>
+  <SOAP-ENV:Fault>
+   <faultcode>SOAP-ENV:Server</faultcode>
+   <faultstring>Internal Error</faultstring>
+  </SOAP-ENV:Fault>
+ </SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+	body, err := ReadEnvelope(bytes.NewReader([]byte(doc)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fe, ok := body.Fault.Err().(*FaultError)
+	if !ok || fe.Code != "SOAP-ENV:Server" || fe.String != "Internal Error" {
+		t.Fatalf("%#v", body.Fault.Err())
+	}
+}
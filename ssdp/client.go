@@ -0,0 +1,390 @@
+package ssdp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	// ST that matches every device and service.
+	All = "ssdp:all"
+	// ST that matches root devices.
+	RootDevice = rootDevice
+	// Used for advertisements without a usable CACHE-CONTROL.
+	defaultMaxAge = 30 * time.Minute
+)
+
+// Advertisement is a device or service announced by a NOTIFY, or found by an
+// M-SEARCH.
+type Advertisement struct {
+	// The NT of a NOTIFY, or the ST of a search response.
+	Type string
+	USN  string
+	// ssdp:alive, ssdp:byebye or ssdp:update for a NOTIFY, and empty for a
+	// search response.
+	NTS      string
+	Location string
+	Server   string
+	MaxAge   time.Duration
+	BootID   uint32
+	ConfigID uint32
+	From     *net.UDPAddr
+	Header   http.Header
+}
+
+// UUID returns the device UUID part of the USN, with its "uuid:" prefix.
+func (a Advertisement) UUID() string {
+	return strings.SplitN(a.USN, "::", 2)[0]
+}
+
+func parseMaxAge(cacheControl string) (time.Duration, bool) {
+	for _, d := range strings.Split(cacheControl, ",") {
+		d = strings.TrimSpace(d)
+		if !strings.HasPrefix(strings.ToLower(d), "max-age") {
+			continue
+		}
+		kv := strings.SplitN(d, "=", 2)
+		if len(kv) != 2 {
+			break
+		}
+		secs, err := strconv.ParseUint(strings.TrimSpace(kv[1]), 10, 32)
+		if err != nil {
+			break
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	return 0, false
+}
+
+func advertisementFromHeader(h http.Header, typeHeader string, from *net.UDPAddr) (a Advertisement) {
+	a = Advertisement{
+		Type:     h.Get(typeHeader),
+		USN:      h.Get("USN"),
+		NTS:      h.Get("NTS"),
+		Location: h.Get("LOCATION"),
+		Server:   h.Get("SERVER"),
+		From:     from,
+		Header:   h,
+	}
+	if maxAge, ok := parseMaxAge(h.Get("CACHE-CONTROL")); ok {
+		a.MaxAge = maxAge
+	} else {
+		a.MaxAge = defaultMaxAge
+	}
+	if i, err := strconv.ParseUint(h.Get("BOOTID.UPNP.ORG"), 10, 32); err == nil {
+		a.BootID = uint32(i)
+	}
+	if i, err := strconv.ParseUint(h.Get("CONFIGID.UPNP.ORG"), 10, 32); err == nil {
+		a.ConfigID = uint32(i)
+	}
+	return
+}
+
+// ParseResponse parses an M-SEARCH response datagram.
+func ParseResponse(b []byte, from *net.UDPAddr) (a Advertisement, err error) {
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), nil)
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("unexpected status %q", resp.Status)
+		return
+	}
+	a = advertisementFromHeader(resp.Header, "ST", from)
+	if a.USN == "" {
+		err = errors.New("missing USN")
+	}
+	return
+}
+
+// ParseNotify parses a NOTIFY datagram.
+func ParseNotify(b []byte, from *net.UDPAddr) (a Advertisement, err error) {
+	req, err := ReadRequest(bufio.NewReader(bytes.NewReader(b)))
+	if err != nil {
+		return
+	}
+	if req.Method != "NOTIFY" {
+		err = fmt.Errorf("unexpected method %q", req.Method)
+		return
+	}
+	a = advertisementFromHeader(req.Header, "NT", from)
+	if a.USN == "" {
+		err = errors.New("missing USN")
+	}
+	return
+}
+
+// Client searches for and listens to advertisements. The zero value uses
+// IPv4 and the system's default multicast interface.
+type Client struct {
+	// The interface to search and listen on.
+	Interface *net.Interface
+	IPv6      bool
+}
+
+func (c *Client) group() group {
+	if c.IPv6 {
+		return groups6[0]
+	}
+	return groups4[0]
+}
+
+// Search sends an M-SEARCH for st, and calls handle with each response until
+// mx has passed, plus a second for stragglers, or ctx is done. mx is clamped
+// to 1-5 seconds as the spec requires.
+func (c *Client) Search(ctx context.Context, st string, mx time.Duration, handle func(Advertisement)) error {
+	if mx < time.Second {
+		mx = time.Second
+	} else if mx > 5*time.Second {
+		mx = 5 * time.Second
+	}
+	g := c.group()
+	network := "udp4"
+	if c.IPv6 {
+		network = "udp6"
+	}
+	conn, err := net.ListenUDP(network, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if c.Interface != nil {
+		if c.IPv6 {
+			err = ipv6.NewPacketConn(conn).SetMulticastInterface(c.Interface)
+		} else {
+			err = ipv4.NewPacketConn(conn).SetMulticastInterface(c.Interface)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	msg := []byte("M-SEARCH * HTTP/1.1\r\n" +
+		"HOST: " + g.host + "\r\n" +
+		`MAN: "ssdp:discover"` + "\r\n" +
+		"MX: " + strconv.Itoa(int(mx/time.Second)) + "\r\n" +
+		"ST: " + st + "\r\n" +
+		"\r\n")
+	// UDP is unreliable, the spec suggests sending searches more than once.
+	for i := 0; i < 2; i++ {
+		if _, err := conn.WriteToUDP(msg, g.addr); err != nil {
+			return err
+		}
+	}
+	deadline := time.Now().Add(mx + time.Second)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetReadDeadline(deadline)
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.SetReadDeadline(time.Now())
+		case <-stop:
+		}
+	}()
+	seen := make(map[string]bool)
+	b := make([]byte, 0x10000)
+	for {
+		n, from, err := conn.ReadFromUDP(b)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				return ctx.Err()
+			}
+			return err
+		}
+		a, err := ParseResponse(b[:n], from)
+		if err != nil {
+			continue
+		}
+		// Responses to the repeated search are duplicates.
+		if key := a.USN + " " + a.Location; !seen[key] {
+			seen[key] = true
+			handle(a)
+		}
+	}
+}
+
+// Search returns the responses to an M-SEARCH sent with the default Client.
+func Search(ctx context.Context, st string, mx time.Duration) (ret []Advertisement, err error) {
+	var c Client
+	err = c.Search(ctx, st, mx, func(a Advertisement) {
+		ret = append(ret, a)
+	})
+	return
+}
+
+// Listen calls handle with each NOTIFY received, until ctx is done.
+func (c *Client) Listen(ctx context.Context, handle func(Advertisement)) error {
+	conn, err := makeConn(func() net.Interface {
+		if c.Interface != nil {
+			return *c.Interface
+		}
+		return net.Interface{}
+	}(), c.IPv6)
+	if err != nil {
+		return err
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-stop:
+		}
+	}()
+	b := make([]byte, 0x10000)
+	for {
+		n, from, err := conn.ReadFromUDP(b)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			conn.Close()
+			return err
+		}
+		a, err := ParseNotify(b[:n], from)
+		if err != nil {
+			continue
+		}
+		handle(a)
+	}
+}
+
+// Registry keeps the advertisements that are currently alive, keyed by USN.
+// Entries expire after their max-age, and are removed by ssdp:byebye.
+type Registry struct {
+	mu      sync.Mutex
+	entries map[string]*RegistryEntry
+	now     func() time.Time
+	// Called without the registry locked when an entry is added or removed.
+	OnAdd    func(RegistryEntry)
+	OnRemove func(RegistryEntry)
+}
+
+type RegistryEntry struct {
+	Advertisement
+	Expires time.Time
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		entries: make(map[string]*RegistryEntry),
+		now:     time.Now,
+	}
+}
+
+// Handle updates the registry with an advertisement.
+func (r *Registry) Handle(a Advertisement) {
+	r.mu.Lock()
+	old, existed := r.entries[a.USN]
+	var added, removed *RegistryEntry
+	switch a.NTS {
+	case byebyeNTS:
+		if existed {
+			delete(r.entries, a.USN)
+			removed = old
+		}
+	case updateNTS:
+		// Only the BOOTID changes, and there's no max-age to extend.
+		if existed {
+			if next, err := strconv.ParseUint(a.Header.Get("NEXTBOOTID.UPNP.ORG"), 10, 32); err == nil {
+				old.BootID = uint32(next)
+			}
+		}
+	default:
+		e := &RegistryEntry{a, r.now().Add(a.MaxAge)}
+		r.entries[a.USN] = e
+		if !existed || old.Location != a.Location {
+			added = e
+		}
+	}
+	r.mu.Unlock()
+	if removed != nil && r.OnRemove != nil {
+		r.OnRemove(*removed)
+	}
+	if added != nil && r.OnAdd != nil {
+		r.OnAdd(*added)
+	}
+}
+
+// Expire removes entries whose max-age has passed.
+func (r *Registry) Expire() {
+	r.mu.Lock()
+	now := r.now()
+	var expired []RegistryEntry
+	for usn, e := range r.entries {
+		if !now.Before(e.Expires) {
+			delete(r.entries, usn)
+			expired = append(expired, *e)
+		}
+	}
+	r.mu.Unlock()
+	if r.OnRemove != nil {
+		for _, e := range expired {
+			r.OnRemove(e)
+		}
+	}
+}
+
+// Entries returns the live entries whose type is st, or all of them for
+// ssdp:all, ordered by USN.
+func (r *Registry) Entries(st string) (ret []RegistryEntry) {
+	r.Expire()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.entries {
+		if st == All || e.Type == st {
+			ret = append(ret, *e)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].USN < ret[j].USN
+	})
+	return
+}
+
+// Watch keeps the registry up to date until ctx is done. It listens for
+// announcements with c, and searches for st every searchInterval, so that
+// devices that were already on the network are found.
+func (r *Registry) Watch(ctx context.Context, c *Client, st string, searchInterval time.Duration) error {
+	handle := func(a Advertisement) {
+		if st == All || a.Type == st {
+			r.Handle(a)
+		}
+	}
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- c.Listen(ctx, handle)
+	}()
+	for {
+		if err := c.Search(ctx, st, 2*time.Second, handle); err != nil && ctx.Err() == nil {
+			log.Printf("ssdp search error: %s", err)
+		}
+		r.Expire()
+		select {
+		case <-time.After(searchInterval):
+		case err := <-listenErr:
+			return err
+		case <-ctx.Done():
+			return <-listenErr
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package ssdp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseResponse(t *testing.T) {
+	a, err := ParseResponse([]byte("HTTP/1.1 200 OK\r\n"+
+		"CACHE-CONTROL: max-age=100\r\n"+
+		"EXT:\r\n"+
+		"LOCATION: http://192.168.1.2:1338/rootDesc.xml\r\n"+
+		"SERVER: Linux/3.4 UPnP/1.1 DMS/1.0\r\n"+
+		"ST: upnp:rootdevice\r\n"+
+		"USN: uuid:abc::upnp:rootdevice\r\n"+
+		"BOOTID.UPNP.ORG: 7\r\n"+
+		"\r\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Type != rootDevice || a.UUID() != "uuid:abc" || a.MaxAge != 100*time.Second || a.BootID != 7 {
+		t.Fatalf("%+v", a)
+	}
+	if a.Location != "http://192.168.1.2:1338/rootDesc.xml" {
+		t.Fatal(a.Location)
+	}
+}
+
+func TestParseNotify(t *testing.T) {
+	a, err := ParseNotify([]byte("NOTIFY * HTTP/1.1\r\n"+
+		"HOST: 239.255.255.250:1900\r\n"+
+		"NT: upnp:rootdevice\r\n"+
+		"NTS: ssdp:byebye\r\n"+
+		"USN: uuid:abc::upnp:rootdevice\r\n"+
+		"\r\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.NTS != byebyeNTS || a.MaxAge != defaultMaxAge {
+		t.Fatalf("%+v", a)
+	}
+	if _, err := ParseNotify([]byte("M-SEARCH * HTTP/1.1\r\n\r\n"), nil); err == nil {
+		t.Fatal("expected error for M-SEARCH")
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	now := time.Unix(0, 0)
+	r := NewRegistry()
+	r.now = func() time.Time { return now }
+	var added, removed []string
+	r.OnAdd = func(e RegistryEntry) { added = append(added, e.USN) }
+	r.OnRemove = func(e RegistryEntry) { removed = append(removed, e.USN) }
+	r.Handle(Advertisement{Type: rootDevice, USN: "uuid:a::upnp:rootdevice", NTS: aliveNTS, MaxAge: time.Minute})
+	r.Handle(Advertisement{Type: rootDevice, USN: "uuid:b::upnp:rootdevice", MaxAge: 2 * time.Minute})
+	// Refreshing an entry doesn't report it again.
+	r.Handle(Advertisement{Type: rootDevice, USN: "uuid:a::upnp:rootdevice", NTS: aliveNTS, MaxAge: time.Minute})
+	if len(added) != 2 || len(r.Entries(All)) != 2 {
+		t.Fatal(added)
+	}
+	if len(r.Entries("urn:schemas-upnp-org:device:MediaServer:1")) != 0 {
+		t.Fatal("unexpected match")
+	}
+	now = now.Add(time.Minute)
+	if es := r.Entries(rootDevice); len(es) != 1 || es[0].USN != "uuid:b::upnp:rootdevice" {
+		t.Fatal(es)
+	}
+	r.Handle(Advertisement{Type: rootDevice, USN: "uuid:b::upnp:rootdevice", NTS: byebyeNTS})
+	if len(r.Entries(All)) != 0 || len(removed) != 2 {
+		t.Fatal(removed)
+	}
+}
@@ -0,0 +1,134 @@
+package ssdp
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Searches a sender can make in a burst, and the rate they're replenished
+	// at. Control points send a few copies of each search, for a few targets.
+	senderSearchBurst = 20
+	senderSearchRate  = 2 // per second
+	// Senders tracked before the quietest are forgotten.
+	maxTrackedSenders = 1024
+	// MX bounds, see UDA 1.1 section 1.3.2.
+	minMX = 1
+	maxMX = 5
+)
+
+// Splits a URN search target or type of the form
+// urn:domain:device:type:ver into the part before the version, and the
+// version.
+func splitTypeVersion(s string) (base string, ver uint64, ok bool) {
+	if !strings.HasPrefix(s, "urn:") {
+		return
+	}
+	i := strings.LastIndexByte(s, ':')
+	ver, err := strconv.ParseUint(s[i+1:], 10, 32)
+	if err != nil || strings.Count(s, ":") != 4 {
+		return
+	}
+	return s[:i], ver, true
+}
+
+// Returns the targets to respond to a search for st with, following UDA 1.1
+// section 1.3.2. A device or service type matches searches for the same or an
+// earlier version, and the response echoes the version searched for.
+func (me *Server) searchTypes(st string) []string {
+	return me.ownDevice().searchTypes(st)
+}
+
+func (me *Device) searchTypes(st string) []string {
+	switch {
+	case st == "ssdp:all":
+		return me.allTypes()
+	case st == rootDevice:
+		return []string{rootDevice}
+	case strings.HasPrefix(strings.ToLower(st), "uuid:"):
+		if strings.EqualFold(st, me.UUID) {
+			return []string{me.UUID}
+		}
+		return nil
+	}
+	stBase, stVer, ok := splitTypeVersion(st)
+	if !ok {
+		return nil
+	}
+	for _, t := range append(me.Devices[:len(me.Devices):len(me.Devices)], me.Services...) {
+		base, ver, ok := splitTypeVersion(t)
+		if ok && base == stBase && ver >= stVer {
+			return []string{st}
+		}
+	}
+	return nil
+}
+
+// Clamps an MX header value to the range the spec allows. ok is false if
+// it's not a number.
+func parseMX(s string) (mx uint64, ok bool) {
+	mx, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+	if err != nil {
+		return
+	}
+	if mx < minMX {
+		mx = minMX
+	} else if mx > maxMX {
+		mx = maxMX
+	}
+	return mx, true
+}
+
+type senderBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limits the rate of searches answered per sender, with a token bucket for
+// each sender IP.
+type senderLimiter struct {
+	mu      sync.Mutex
+	senders map[string]*senderBucket
+}
+
+// Takes a token from the sender's bucket, returning false if it's empty.
+func (l *senderLimiter) allow(sender string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.senders == nil {
+		l.senders = make(map[string]*senderBucket)
+	}
+	b, ok := l.senders[sender]
+	if !ok {
+		if len(l.senders) >= maxTrackedSenders {
+			l.prune(now)
+		}
+		if len(l.senders) >= maxTrackedSenders {
+			return false
+		}
+		b = &senderBucket{tokens: senderSearchBurst, last: now}
+		l.senders[sender] = b
+	}
+	b.tokens += now.Sub(b.last).Seconds() * senderSearchRate
+	if b.tokens > senderSearchBurst {
+		b.tokens = senderSearchBurst
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Forgets senders whose buckets have refilled, as they're the same as new
+// ones.
+func (l *senderLimiter) prune(now time.Time) {
+	for s, b := range l.senders {
+		if b.tokens+now.Sub(b.last).Seconds()*senderSearchRate >= senderSearchBurst {
+			delete(l.senders, s)
+		}
+	}
+}
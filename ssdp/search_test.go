@@ -0,0 +1,73 @@
+package ssdp
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSearchTypes(t *testing.T) {
+	s := &Server{
+		UUID:     "uuid:abc",
+		Devices:  []string{"urn:schemas-upnp-org:device:MediaServer:2"},
+		Services: []string{"urn:schemas-upnp-org:service:ContentDirectory:1"},
+	}
+	for _, c := range []struct {
+		st   string
+		want []string
+	}{
+		{"ssdp:all", s.allTypes()},
+		{"upnp:rootdevice", []string{"upnp:rootdevice"}},
+		{"uuid:ABC", []string{"uuid:abc"}},
+		{"uuid:abd", nil},
+		{"urn:schemas-upnp-org:device:MediaServer:1", []string{"urn:schemas-upnp-org:device:MediaServer:1"}},
+		{"urn:schemas-upnp-org:device:MediaServer:2", []string{"urn:schemas-upnp-org:device:MediaServer:2"}},
+		{"urn:schemas-upnp-org:device:MediaServer:3", nil},
+		{"urn:schemas-upnp-org:device:MediaServer", nil},
+		{"urn:schemas-upnp-org:service:ContentDirectory:1", []string{"urn:schemas-upnp-org:service:ContentDirectory:1"}},
+		{"urn:schemas-upnp-org:device:ContentDirectory:1", nil},
+		{"urn:schemas-upnp-org:service:ConnectionManager:1", nil},
+	} {
+		if got := s.searchTypes(c.st); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.st, got, c.want)
+		}
+	}
+}
+
+func TestParseMX(t *testing.T) {
+	for s, want := range map[string]uint64{"0": 1, "1": 1, "3": 3, "5": 5, "120": 5} {
+		if mx, ok := parseMX(s); !ok || mx != want {
+			t.Errorf("%s: got %d, %v", s, mx, ok)
+		}
+	}
+	if _, ok := parseMX("-1"); ok {
+		t.Error("negative mx accepted")
+	}
+}
+
+func TestSenderLimiter(t *testing.T) {
+	var l senderLimiter
+	now := time.Unix(0, 0)
+	for i := 0; i < senderSearchBurst; i++ {
+		if !l.allow("a", now) {
+			t.Fatal("burst denied")
+		}
+	}
+	if l.allow("a", now) {
+		t.Fatal("exceeded burst")
+	}
+	if !l.allow("b", now) {
+		t.Fatal("other sender limited")
+	}
+	if !l.allow("a", now.Add(time.Second/senderSearchRate)) {
+		t.Fatal("bucket not replenished")
+	}
+	// Quiet senders make way for new ones.
+	for i := 0; i < maxTrackedSenders; i++ {
+		l.allow(strconv.Itoa(i), now)
+	}
+	if !l.allow("new", now.Add(time.Minute)) {
+		t.Fatal("new sender denied")
+	}
+}
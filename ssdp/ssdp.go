@@ -12,27 +12,62 @@ import (
 	"net/textproto"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
 )
 
 const (
 	AddrString = "239.255.255.250:1900"
-	rootDevice = "upnp:rootdevice"
-	aliveNTS   = "ssdp:alive"
-	byebyeNTS  = "ssdp:byebye"
+	// The IPv6 link-local and site-local SSDP multicast groups.
+	AddrString6LinkLocal = "[FF02::C]:1900"
+	AddrString6SiteLocal = "[FF05::C]:1900"
+	rootDevice           = "upnp:rootdevice"
+	aliveNTS             = "ssdp:alive"
+	byebyeNTS            = "ssdp:byebye"
+	updateNTS            = "ssdp:update"
 )
 
 var (
-	NetAddr *net.UDPAddr
+	NetAddr           *net.UDPAddr
+	NetAddr6LinkLocal *net.UDPAddr
+	NetAddr6SiteLocal *net.UDPAddr
 )
 
 func init() {
-	var err error
-	NetAddr, err = net.ResolveUDPAddr("udp4", AddrString)
-	if err != nil {
-		log.Panicf("Could not resolve %s: %s", AddrString, err)
+	for _, a := range []struct {
+		addr    **net.UDPAddr
+		network string
+		s       string
+	}{
+		{&NetAddr, "udp4", AddrString},
+		{&NetAddr6LinkLocal, "udp6", AddrString6LinkLocal},
+		{&NetAddr6SiteLocal, "udp6", AddrString6SiteLocal},
+	} {
+		var err error
+		*a.addr, err = net.ResolveUDPAddr(a.network, a.s)
+		if err != nil {
+			log.Panicf("Could not resolve %s: %s", a.s, err)
+		}
+	}
+}
+
+// A multicast group that a server joins and announces to.
+type group struct {
+	addr *net.UDPAddr
+	// The HOST header value for messages sent to the group.
+	host string
+}
+
+var groups4, groups6 []group
+
+func init() {
+	groups4 = []group{{NetAddr, AddrString}}
+	groups6 = []group{
+		{NetAddr6LinkLocal, AddrString6LinkLocal},
+		{NetAddr6SiteLocal, AddrString6SiteLocal},
 	}
 }
 
@@ -79,20 +114,74 @@ func ReadRequest(b *bufio.Reader) (req *http.Request, err error) {
 	return
 }
 
+// PacketConn is what a Server sends and receives datagrams with.
+// *net.UDPConn implements it.
+type PacketConn interface {
+	ReadFromUDP(b []byte) (int, *net.UDPAddr, error)
+	WriteToUDP(b []byte, addr *net.UDPAddr) (int, error)
+	Close() error
+}
+
+// Clock is the time source a Server schedules its messages with.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Device is another root device for a Server to advertise, such as
+// another virtual server on the same host. It shares the Server's socket and
+// BOOTID.
+type Device struct {
+	UUID     string
+	Devices  []string
+	Services []string
+	Location func(net.IP) string
+	// Use Server.ReconfigureDevice to change it once the server is serving.
+	ConfigID uint32
+}
+
 type Server struct {
-	conn           *net.UDPConn
-	Interface      net.Interface
+	// The transport. If nil, Init creates a multicast socket on Interface.
+	Conn      PacketConn
+	Interface net.Interface
+	// Returns the addresses to advertise on. Defaults to Interface.Addrs.
+	Addrs func() ([]net.Addr, error)
+	// Defaults to the system clock.
+	Clock          Clock
 	Server         string
 	Services       []string
 	Devices        []string
 	Location       func(net.IP) string
 	UUID           string
 	NotifyInterval time.Duration
-	closed         chan struct{}
+	// BOOTID.UPNP.ORG and CONFIGID.UPNP.ORG, see UDA 1.1 section 1.2. Use
+	// Update and Reconfigure to change them once the server is serving.
+	BootID   uint32
+	ConfigID uint32
+	// Root devices advertised in addition to the one described above. Their
+	// UUIDs must differ from it and each other.
+	OtherDevices []*Device
+	// Use the IPv6 SSDP groups and the interface's IPv6 addresses rather than
+	// IPv4. Run a server of each kind to serve both.
+	IPv6    bool
+	mu      sync.Mutex
+	closed  chan struct{}
+	limiter senderLimiter
+	// Searches with responses scheduled, by sender address and ST. Repeats
+	// of a search are ignored until its responses are sent.
+	pending map[string]bool
 }
 
-func makeConn(ifi net.Interface) (ret *net.UDPConn, err error) {
-	ret, err = net.ListenMulticastUDP("udp", &ifi, NetAddr)
+func makeConn(ifi net.Interface, v6 bool) (ret *net.UDPConn, err error) {
+	if v6 {
+		return makeConn6(ifi)
+	}
+	ret, err = net.ListenMulticastUDP("udp4", &ifi, NetAddr)
 	if err != nil {
 		return
 	}
@@ -106,10 +195,78 @@ func makeConn(ifi net.Interface) (ret *net.UDPConn, err error) {
 	return
 }
 
+// Listens on the link-local group, and joins the site-local group too. Both
+// have the same port, so one socket receives from both.
+func makeConn6(ifi net.Interface) (ret *net.UDPConn, err error) {
+	ret, err = net.ListenMulticastUDP("udp6", &ifi, NetAddr6LinkLocal)
+	if err != nil {
+		return
+	}
+	p := ipv6.NewPacketConn(ret)
+	if err := p.JoinGroup(&ifi, NetAddr6SiteLocal); err != nil {
+		log.Println(err)
+	}
+	if err := p.SetMulticastHopLimit(2); err != nil {
+		log.Println(err)
+	}
+	if err := p.SetMulticastLoopback(true); err != nil {
+		log.Println(err)
+	}
+	return
+}
+
+func (me *Server) groups() []group {
+	if me.IPv6 {
+		return groups6
+	}
+	return groups4
+}
+
+// Returns the interface's addresses of the server's IP version.
+func (me *Server) addrs() (ret []*net.IPNet, err error) {
+	var addrs []net.Addr
+	if me.Addrs != nil {
+		addrs, err = me.Addrs()
+	} else {
+		addrs, err = me.Interface.Addrs()
+	}
+	if err != nil {
+		return
+	}
+	for _, addr := range addrs {
+		var ipNet *net.IPNet
+		switch val := addr.(type) {
+		case *net.IPNet:
+			ipNet = val
+		case *net.IPAddr:
+			// Without a mask, any sender is assumed to be reachable.
+			ipNet = &net.IPNet{IP: val.IP, Mask: net.CIDRMask(0, 8*len(val.IP))}
+		default:
+			continue
+		}
+		if (ipNet.IP.To4() == nil) != me.IPv6 {
+			continue
+		}
+		ret = append(ret, ipNet)
+	}
+	return
+}
+
+func (me *Server) clock() Clock {
+	if me.Clock == nil {
+		return systemClock{}
+	}
+	return me.Clock
+}
+
 func (me *Server) serve() {
+	bufSize := me.Interface.MTU
+	if bufSize <= 0 {
+		bufSize = 0x10000
+	}
 	for {
-		b := make([]byte, me.Interface.MTU)
-		n, addr, err := me.conn.ReadFromUDP(b)
+		b := make([]byte, bufSize)
+		n, addr, err := me.Conn.ReadFromUDP(b)
 		select {
 		case <-me.closed:
 			return
@@ -119,63 +276,169 @@ func (me *Server) serve() {
 			log.Printf("error reading from UDP socket: %s", err)
 			break
 		}
-		go me.handle(b[:n], addr)
+		// Handling doesn't block, responses are sent later.
+		me.handle(b[:n], addr)
 	}
 }
 
 func (me *Server) Init() (err error) {
 	me.closed = make(chan struct{})
-	me.conn, err = makeConn(me.Interface)
+	me.pending = make(map[string]bool)
+	if me.Conn == nil {
+		me.Conn, err = makeConn(me.Interface, me.IPv6)
+	}
 	return
 }
 
 func (me *Server) Close() {
 	close(me.closed)
 	me.sendByeBye()
-	me.conn.Close()
+	me.Conn.Close()
 }
 
 func (me *Server) Serve() (err error) {
 	go me.serve()
 	for {
-		addrs, err := me.Interface.Addrs()
+		addrs, err := me.addrs()
 		if err != nil {
 			return err
 		}
 		for _, addr := range addrs {
-			ip := func() net.IP {
-				switch val := addr.(type) {
-				case *net.IPNet:
-					return val.IP
-				case *net.IPAddr:
-					return val.IP
+			me.notifyAlive(addr.IP)
+		}
+		select {
+		case <-me.clock().After(me.NotifyInterval):
+		case <-me.closed:
+			return nil
+		}
+	}
+}
+
+// Returns the root device described by the server's own fields.
+func (me *Server) ownDevice() *Device {
+	return &Device{
+		UUID:     me.UUID,
+		Devices:  me.Devices,
+		Services: me.Services,
+		Location: me.Location,
+	}
+}
+
+// Returns all the root devices advertised, the server's own first.
+func (me *Server) devices() []*Device {
+	return append([]*Device{me.ownDevice()}, me.OtherDevices...)
+}
+
+func (me *Server) notifyAlive(ip net.IP) {
+	for _, d := range me.devices() {
+		me.notifyDeviceAlive(d, ip)
+	}
+}
+
+func (me *Server) notifyDeviceAlive(d *Device, ip net.IP) {
+	extraHdrs := [][2]string{
+		{"CACHE-CONTROL", fmt.Sprintf("max-age=%d", 5*me.NotifyInterval/2/time.Second)},
+		{"LOCATION", d.Location(ip)},
+	}
+	me.notifyAll(d, aliveNTS, extraHdrs)
+}
+
+// Returns the BOOTID.UPNP.ORG and CONFIGID.UPNP.ORG headers for the device.
+func (me *Server) idHeaders(d *Device) [][2]string {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	configID := d.ConfigID
+	if d.UUID == me.UUID {
+		configID = me.ConfigID
+	}
+	return [][2]string{
+		{"BOOTID.UPNP.ORG", strconv.FormatUint(uint64(me.BootID), 10)},
+		{"CONFIGID.UPNP.ORG", strconv.FormatUint(uint64(configID), 10)},
+	}
+}
+
+// Update announces that the BOOTID is changing to nextBootID with
+// ssdp:update messages, such as when the device starts advertising on
+// another interface, then uses it for subsequent messages.
+func (me *Server) Update(nextBootID uint32) error {
+	addrs, err := me.addrs()
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		for _, d := range me.devices() {
+			for _, type_ := range d.allTypes() {
+				for _, g := range me.groups() {
+					buf := me.makeNotifyMessage(d, g.host, type_, updateNTS, [][2]string{
+						{"LOCATION", d.Location(addr.IP)},
+						{"NEXTBOOTID.UPNP.ORG", strconv.FormatUint(uint64(nextBootID), 10)},
+					})
+					me.send(buf, g.addr)
 				}
-				panic(fmt.Sprint("unexpected addr type:", addr))
-			}()
-			extraHdrs := [][2]string{
-				{"CACHE-CONTROL", fmt.Sprintf("max-age=%d", 5*me.NotifyInterval/2/time.Second)},
-				{"LOCATION", me.Location(ip)},
 			}
-			me.notifyAll(aliveNTS, extraHdrs)
 		}
-		time.Sleep(me.NotifyInterval)
 	}
+	me.mu.Lock()
+	me.BootID = nextBootID
+	me.mu.Unlock()
+	return nil
+}
+
+// Reconfigure announces a change of the device or service descriptions. The
+// current advertisements are cancelled, and then renewed with the new
+// CONFIGID.
+func (me *Server) Reconfigure(configID uint32) error {
+	return me.ReconfigureDevice(me.UUID, configID)
+}
+
+// ReconfigureDevice is Reconfigure for the root device with the UUID, which
+// can be one of the OtherDevices.
+func (me *Server) ReconfigureDevice(uuid string, configID uint32) error {
+	var d *Device
+	for _, d_ := range me.devices() {
+		if d_.UUID == uuid {
+			d = d_
+		}
+	}
+	if d == nil {
+		return fmt.Errorf("no root device %q", uuid)
+	}
+	me.sendDeviceByeBye(d)
+	me.mu.Lock()
+	if uuid == me.UUID {
+		me.ConfigID = configID
+	} else {
+		d.ConfigID = configID
+	}
+	me.mu.Unlock()
+	addrs, err := me.addrs()
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		me.notifyDeviceAlive(d, addr.IP)
+	}
+	return nil
 }
 
 func (me *Server) usnFromTarget(target string) string {
+	return me.ownDevice().usnFromTarget(target)
+}
+
+func (me *Device) usnFromTarget(target string) string {
 	if target == me.UUID {
 		return target
 	}
 	return me.UUID + "::" + target
 }
 
-func (me *Server) makeNotifyMessage(target, nts string, extraHdrs [][2]string) []byte {
+func (me *Server) makeNotifyMessage(d *Device, host, target, nts string, extraHdrs [][2]string) []byte {
 	lines := [...][2]string{
-		{"HOST", AddrString},
+		{"HOST", host},
 		{"NT", target},
 		{"NTS", nts},
 		{"SERVER", me.Server},
-		{"USN", me.usnFromTarget(target)},
+		{"USN", d.usnFromTarget(target)},
 	}
 	buf := &bytes.Buffer{}
 	fmt.Fprint(buf, "NOTIFY * HTTP/1.1\r\n")
@@ -188,12 +451,15 @@ func (me *Server) makeNotifyMessage(target, nts string, extraHdrs [][2]string) [
 	for _, pair := range extraHdrs {
 		writeHdr(pair)
 	}
+	for _, pair := range me.idHeaders(d) {
+		writeHdr(pair)
+	}
 	fmt.Fprint(buf, "\r\n")
 	return buf.Bytes()
 }
 
 func (me *Server) send(buf []byte, addr *net.UDPAddr) {
-	if n, err := me.conn.WriteToUDP(buf, addr); err != nil {
+	if n, err := me.Conn.WriteToUDP(buf, addr); err != nil {
 		log.Printf("error writing to UDP socket: %s", err)
 	} else if n != len(buf) {
 		log.Printf("short write: %d/%d bytes", n, len(buf))
@@ -201,11 +467,30 @@ func (me *Server) send(buf []byte, addr *net.UDPAddr) {
 }
 
 func (me *Server) delayedSend(delay time.Duration, buf []byte, addr *net.UDPAddr) {
+	me.delayedSendBatch(delay, [][]byte{buf}, addr, nil)
+}
+
+// Sends the messages together after delay, unless the server closes first,
+// and then calls done if it's not nil.
+func (me *Server) delayedSendBatch(delay time.Duration, bufs [][]byte, addr *net.UDPAddr, done func()) {
+	timer := me.clock().After(delay)
 	go func() {
+		if done != nil {
+			defer done()
+		}
+		select {
+		case <-timer:
+		case <-me.closed:
+			return
+		}
+		// Both may have been ready.
 		select {
-		case <-time.After(delay):
-			me.send(buf, addr)
 		case <-me.closed:
+			return
+		default:
+		}
+		for _, buf := range bufs {
+			me.send(buf, addr)
 		}
 	}()
 }
@@ -216,21 +501,45 @@ func (me *Server) log(args ...interface{}) {
 }
 
 func (me *Server) sendByeBye() {
-	for _, type_ := range me.allTypes() {
-		buf := me.makeNotifyMessage(type_, byebyeNTS, nil)
-		me.send(buf, NetAddr)
+	for _, d := range me.devices() {
+		me.sendDeviceByeBye(d)
+	}
+}
+
+func (me *Server) sendDeviceByeBye(d *Device) {
+	for _, type_ := range d.allTypes() {
+		for _, g := range me.groups() {
+			buf := me.makeNotifyMessage(d, g.host, type_, byebyeNTS, nil)
+			me.send(buf, g.addr)
+		}
+	}
+}
+
+func (me *Server) notifyAll(d *Device, nts string, extraHdrs [][2]string) {
+	for _, type_ := range d.allTypes() {
+		for _, g := range me.groups() {
+			buf := me.makeNotifyMessage(d, g.host, type_, nts, extraHdrs)
+			delay := time.Duration(rand.Int63n(int64(100 * time.Millisecond)))
+			me.delayedSend(delay, buf, g.addr)
+		}
 	}
 }
 
-func (me *Server) notifyAll(nts string, extraHdrs [][2]string) {
-	for _, type_ := range me.allTypes() {
-		buf := me.makeNotifyMessage(type_, nts, extraHdrs)
-		delay := time.Duration(rand.Int63n(int64(100 * time.Millisecond)))
-		me.delayedSend(delay, buf, NetAddr)
+// Whether a HOST header names one of the server's multicast groups.
+func (me *Server) isGroupHost(host string) bool {
+	for _, g := range me.groups() {
+		if strings.EqualFold(host, g.host) {
+			return true
+		}
 	}
+	return false
 }
 
 func (me *Server) allTypes() (ret []string) {
+	return me.ownDevice().allTypes()
+}
+
+func (me *Device) allTypes() (ret []string) {
 	for _, a := range [][]string{
 		{rootDevice, me.UUID},
 		me.Devices,
@@ -250,81 +559,87 @@ func (me *Server) handle(buf []byte, sender *net.UDPAddr) {
 	if req.Method != "M-SEARCH" || req.Header.Get("man") != `"ssdp:discover"` {
 		return
 	}
-	var mx uint
-	if req.Header.Get("Host") == AddrString {
+	var mx uint64
+	if me.isGroupHost(req.Header.Get("Host")) {
 		mxHeader := req.Header.Get("mx")
-		i, err := strconv.ParseUint(mxHeader, 0, 0)
-		if err != nil {
-			log.Printf("Invalid mx header %q: %s", mxHeader, err)
+		var ok bool
+		mx, ok = parseMX(mxHeader)
+		if !ok {
+			log.Printf("Invalid mx header %q from %s", mxHeader, sender)
 			return
 		}
-		mx = uint(i)
 	} else {
+		// Unicast searches are answered within a second.
 		mx = 1
 	}
-	types := func(st string) []string {
-		if st == "ssdp:all" {
-			return me.allTypes()
+	st := req.Header.Get("st")
+	var devices []*Device
+	var types [][]string
+	for _, d := range me.devices() {
+		if ts := d.searchTypes(st); len(ts) != 0 {
+			devices = append(devices, d)
+			types = append(types, ts)
 		}
-		for _, t := range me.allTypes() {
-			if t == st {
-				return []string{t}
-			}
-		}
-		return nil
-	}(req.Header.Get("st"))
-	for _, ip := range func() (ret []net.IP) {
-		addrs, err := me.Interface.Addrs()
-		if err != nil {
-			panic(err)
+	}
+	if len(devices) == 0 {
+		return
+	}
+	key := sender.String() + " " + st
+	me.mu.Lock()
+	dup := me.pending[key]
+	me.mu.Unlock()
+	if dup || !me.limiter.allow(sender.IP.String(), me.clock().Now()) {
+		return
+	}
+	addrs, err := me.addrs()
+	if err != nil {
+		me.log("error getting addresses:", err)
+		return
+	}
+	var resps [][]byte
+	for _, addr := range addrs {
+		if !addr.Contains(sender.IP) {
+			continue
 		}
-		for _, addr := range addrs {
-			if ip, ok := func() (net.IP, bool) {
-				switch data := addr.(type) {
-				case *net.IPNet:
-					if data.Contains(sender.IP) {
-						return data.IP, true
-					}
-					return nil, false
-				case *net.IPAddr:
-					return data.IP, true
-				}
-				panic(addr)
-			}(); ok {
-				ret = append(ret, ip)
+		for i, d := range devices {
+			for _, type_ := range types[i] {
+				resps = append(resps, me.makeResponse(d, addr.IP, type_))
 			}
 		}
+	}
+	if len(resps) == 0 {
 		return
-	}() {
-		for _, type_ := range types {
-			resp := me.makeResponse(ip, type_, req)
-			delay := time.Duration(rand.Int63n(int64(time.Second) * int64(mx)))
-			me.delayedSend(delay, resp, sender)
-		}
 	}
+	me.mu.Lock()
+	me.pending[key] = true
+	me.mu.Unlock()
+	delay := time.Duration(rand.Int63n(int64(time.Second) * int64(mx)))
+	me.delayedSendBatch(delay, resps, sender, func() {
+		me.mu.Lock()
+		delete(me.pending, key)
+		me.mu.Unlock()
+	})
 }
 
-func (me *Server) makeResponse(ip net.IP, targ string, req *http.Request) (ret []byte) {
-	resp := &http.Response{
-		StatusCode: 200,
-		ProtoMajor: 1,
-		ProtoMinor: 1,
-		Header:     make(http.Header),
-		Request:    req,
+func (me *Server) makeResponse(d *Device, ip net.IP, targ string) (ret []byte) {
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, "HTTP/1.1 200 OK\r\n")
+	writeHdr := func(keyValue [2]string) {
+		fmt.Fprintf(buf, "%s: %s\r\n", keyValue[0], keyValue[1])
 	}
 	for _, pair := range [...][2]string{
 		{"CACHE-CONTROL", fmt.Sprintf("max-age=%d", 5*me.NotifyInterval/2/time.Second)},
 		{"EXT", ""},
-		{"LOCATION", me.Location(ip)},
+		{"LOCATION", d.Location(ip)},
 		{"SERVER", me.Server},
 		{"ST", targ},
-		{"USN", me.usnFromTarget(targ)},
+		{"USN", d.usnFromTarget(targ)},
 	} {
-		resp.Header.Set(pair[0], pair[1])
+		writeHdr(pair)
 	}
-	buf := &bytes.Buffer{}
-	if err := resp.Write(buf); err != nil {
-		panic(err)
+	for _, pair := range me.idHeaders(d) {
+		writeHdr(pair)
 	}
+	fmt.Fprint(buf, "\r\n")
 	return buf.Bytes()
 }
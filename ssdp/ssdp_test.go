@@ -0,0 +1,435 @@
+package ssdp
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A clock that only moves when told to.
+type fakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []fakeTimer
+}
+
+type fakeTimer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	c := &fakeClock{now: time.Unix(1e9, 0)}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := fakeTimer{c.now.Add(d), make(chan time.Time, 1)}
+	c.waiters = append(c.waiters, t)
+	c.cond.Broadcast()
+	return t.c
+}
+
+// Waits until n timers are pending.
+func (c *fakeClock) blockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	var pending []fakeTimer
+	for _, t := range c.waiters {
+		if t.at.After(c.now) {
+			pending = append(pending, t)
+		} else {
+			t.c <- c.now
+		}
+	}
+	c.waiters = pending
+}
+
+type packet struct {
+	b    []byte
+	addr *net.UDPAddr
+}
+
+// An in-memory PacketConn. Packets written to it are delivered to sent, and
+// packets passed to deliver are read by the server.
+type fakeConn struct {
+	in     chan packet
+	sent   chan packet
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newFakeConn() *fakeConn {
+	return &fakeConn{
+		in:     make(chan packet),
+		sent:   make(chan packet, 1000),
+		closed: make(chan struct{}),
+	}
+}
+
+func (c *fakeConn) ReadFromUDP(b []byte) (int, *net.UDPAddr, error) {
+	select {
+	case p := <-c.in:
+		return copy(b, p.b), p.addr, nil
+	case <-c.closed:
+		return 0, nil, errors.New("use of closed connection")
+	}
+}
+
+func (c *fakeConn) WriteToUDP(b []byte, addr *net.UDPAddr) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, errors.New("use of closed connection")
+	default:
+	}
+	c.sent <- packet{append([]byte(nil), b...), addr}
+	return len(b), nil
+}
+
+func (c *fakeConn) Close() error {
+	c.once.Do(func() { close(c.closed) })
+	return nil
+}
+
+func (c *fakeConn) deliver(b string, from *net.UDPAddr) {
+	c.in <- packet{[]byte(b), from}
+}
+
+// Receives n packets, failing if they take too long.
+func (c *fakeConn) receive(t *testing.T, n int) (ret []packet) {
+	t.Helper()
+	for len(ret) < n {
+		select {
+		case p := <-c.sent:
+			ret = append(ret, p)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d of %d packets", len(ret), n)
+		}
+	}
+	return
+}
+
+// Checks nothing more is sent for a little while.
+func (c *fakeConn) expectNothing(t *testing.T) {
+	t.Helper()
+	select {
+	case p := <-c.sent:
+		t.Fatalf("unexpected packet to %s: %q", p.addr, p.b)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+type testServer struct {
+	*Server
+	conn   *fakeConn
+	clock  *fakeClock
+	served chan error
+}
+
+var testServerIP = net.IPv4(192, 168, 1, 2)
+
+func newTestServer(t *testing.T) *testServer {
+	ts := &testServer{
+		conn:  newFakeConn(),
+		clock: newFakeClock(),
+	}
+	ts.Server = &Server{
+		Conn:      ts.conn,
+		Clock:     ts.clock,
+		Interface: net.Interface{Name: "fake0"},
+		Addrs: func() ([]net.Addr, error) {
+			return []net.Addr{
+				&net.IPNet{IP: testServerIP, Mask: net.CIDRMask(24, 32)},
+				&net.IPNet{IP: net.ParseIP("fe80::2"), Mask: net.CIDRMask(64, 128)},
+			}, nil
+		},
+		Server:         "Test/1.0 UPnP/1.1 dms/1.0",
+		UUID:           "uuid:test",
+		Devices:        []string{"urn:schemas-upnp-org:device:MediaServer:1"},
+		Services:       []string{"urn:schemas-upnp-org:service:ContentDirectory:1"},
+		NotifyInterval: 30 * time.Second,
+		Location: func(ip net.IP) string {
+			return "http://" + ip.String() + ":1338/rootDesc.xml"
+		},
+		BootID:   3,
+		ConfigID: 7,
+	}
+	if err := ts.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return ts
+}
+
+// Starts serving, and waits for the initial announcements to be scheduled.
+func (ts *testServer) start() {
+	ts.served = make(chan error, 1)
+	go func() {
+		ts.served <- ts.Serve()
+	}()
+	ts.clock.blockUntil(len(ts.allTypes()) + 1)
+}
+
+func parseNotifies(t *testing.T, ps []packet) (ret []Advertisement) {
+	t.Helper()
+	for _, p := range ps {
+		if !p.addr.IP.Equal(NetAddr.IP) {
+			t.Fatalf("notify sent to %s", p.addr)
+		}
+		a, err := ParseNotify(p.b, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret = append(ret, a)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Type < ret[j].Type
+	})
+	return
+}
+
+func checkTypes(t *testing.T, as []Advertisement, want []string) {
+	t.Helper()
+	var got []string
+	for _, a := range as {
+		got = append(got, a.Type)
+	}
+	sort.Strings(want)
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Fatalf("got types %v, want %v", got, want)
+	}
+}
+
+func TestAliveCycle(t *testing.T) {
+	ts := newTestServer(t)
+	ts.start()
+	ts.conn.expectNothing(t)
+	ts.clock.advance(100 * time.Millisecond)
+	as := parseNotifies(t, ts.conn.receive(t, 4))
+	checkTypes(t, as, ts.allTypes())
+	for _, a := range as {
+		if a.NTS != aliveNTS {
+			t.Fatalf("unexpected NTS %q", a.NTS)
+		}
+		if a.MaxAge != 75*time.Second {
+			t.Fatalf("max-age %s isn't 2.5 notify intervals", a.MaxAge)
+		}
+		if a.Location != "http://192.168.1.2:1338/rootDesc.xml" {
+			t.Fatalf("unexpected location %q", a.Location)
+		}
+		if a.BootID != 3 || a.ConfigID != 7 {
+			t.Fatalf("unexpected ids %d, %d", a.BootID, a.ConfigID)
+		}
+		if want := ts.usnFromTarget(a.Type); a.USN != want {
+			t.Fatalf("got USN %q, want %q", a.USN, want)
+		}
+	}
+	// Announcements are renewed each notify interval.
+	ts.clock.advance(ts.NotifyInterval - 100*time.Millisecond)
+	ts.clock.blockUntil(len(ts.allTypes()) + 1)
+	ts.conn.expectNothing(t)
+	ts.clock.advance(100 * time.Millisecond)
+	checkTypes(t, parseNotifies(t, ts.conn.receive(t, 4)), ts.allTypes())
+	ts.Close()
+	if err := <-ts.served; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCloseSaysByeBye(t *testing.T) {
+	ts := newTestServer(t)
+	ts.start()
+	ts.Close()
+	as := parseNotifies(t, ts.conn.receive(t, 4))
+	checkTypes(t, as, ts.allTypes())
+	for _, a := range as {
+		if a.NTS != byebyeNTS {
+			t.Fatalf("unexpected NTS %q", a.NTS)
+		}
+	}
+	if err := <-ts.served; err != nil {
+		t.Fatal(err)
+	}
+	// Announcements that were scheduled aren't sent once closed.
+	ts.clock.advance(time.Second)
+	ts.conn.expectNothing(t)
+}
+
+func mSearch(st, mx string) string {
+	return "M-SEARCH * HTTP/1.1\r\n" +
+		"HOST: 239.255.255.250:1900\r\n" +
+		"MAN: \"ssdp:discover\"\r\n" +
+		"MX: " + mx + "\r\n" +
+		"ST: " + st + "\r\n" +
+		"\r\n"
+}
+
+func parseResponses(t *testing.T, ps []packet, to *net.UDPAddr) (ret []Advertisement) {
+	t.Helper()
+	for _, p := range ps {
+		if p.addr.String() != to.String() {
+			t.Fatalf("response sent to %s, not %s", p.addr, to)
+		}
+		a, err := ParseResponse(p.b, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := a.Header[http.CanonicalHeaderKey("EXT")]; !ok {
+			t.Fatal("missing EXT header")
+		}
+		ret = append(ret, a)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Type < ret[j].Type
+	})
+	return
+}
+
+func TestSearchResponses(t *testing.T) {
+	ts := newTestServer(t)
+	// Only handle searches, without announcing.
+	go ts.serve()
+	defer ts.Close()
+	sender := &net.UDPAddr{IP: net.IPv4(192, 168, 1, 9), Port: 40000}
+	ts.conn.deliver(mSearch("ssdp:all", "2"), sender)
+	// The repeat is ignored while the first is pending.
+	ts.conn.deliver(mSearch("ssdp:all", "2"), sender)
+	ts.clock.blockUntil(1)
+	ts.conn.expectNothing(t)
+	ts.clock.advance(2 * time.Second)
+	as := parseResponses(t, ts.conn.receive(t, 4), sender)
+	checkTypes(t, as, ts.allTypes())
+	for _, a := range as {
+		if a.MaxAge != 75*time.Second || a.Location != "http://192.168.1.2:1338/rootDesc.xml" {
+			t.Fatalf("%+v", a)
+		}
+	}
+	ts.conn.expectNothing(t)
+
+	// Searches for earlier versions are answered in kind.
+	ts.conn.deliver(mSearch("urn:schemas-upnp-org:device:MediaServer:1", "1"), sender)
+	ts.clock.blockUntil(1)
+	ts.clock.advance(time.Second)
+	as = parseResponses(t, ts.conn.receive(t, 1), sender)
+	if as[0].USN != "uuid:test::urn:schemas-upnp-org:device:MediaServer:1" {
+		t.Fatal(as[0].USN)
+	}
+
+	// Senders outside the interface's networks aren't answered.
+	ts.conn.deliver(mSearch("ssdp:all", "1"), &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1900})
+	// Nor are searches for things we don't have.
+	ts.conn.deliver(mSearch("urn:schemas-upnp-org:device:MediaRenderer:1", "1"), sender)
+	ts.clock.advance(5 * time.Second)
+	ts.conn.expectNothing(t)
+}
+
+func TestSearchDelayBoundedByMX(t *testing.T) {
+	ts := newTestServer(t)
+	// Only handle searches, without announcing.
+	go ts.serve()
+	defer ts.Close()
+	sender := &net.UDPAddr{IP: net.IPv4(192, 168, 1, 9), Port: 40000}
+	// MX above 5 is treated as 5.
+	ts.conn.deliver(mSearch("upnp:rootdevice", "120"), sender)
+	ts.clock.blockUntil(1)
+	ts.clock.advance(5 * time.Second)
+	ts.conn.receive(t, 1)
+	// An invalid MX is ignored.
+	ts.conn.deliver(mSearch("upnp:rootdevice", "soon"), sender)
+	ts.clock.advance(5 * time.Second)
+	ts.conn.expectNothing(t)
+}
+
+func TestUpdateAnnouncesNextBootID(t *testing.T) {
+	ts := newTestServer(t)
+	if err := ts.Update(4); err != nil {
+		t.Fatal(err)
+	}
+	as := parseNotifies(t, ts.conn.receive(t, 4))
+	for _, a := range as {
+		if a.NTS != updateNTS || a.BootID != 3 || a.Header.Get("NEXTBOOTID.UPNP.ORG") != "4" {
+			t.Fatalf("%+v", a)
+		}
+	}
+	if ts.BootID != 4 {
+		t.Fatal(ts.BootID)
+	}
+}
+
+func TestRootDevices(t *testing.T) {
+	ts := newTestServer(t)
+	other := &Device{
+		UUID:     "uuid:other",
+		Devices:  ts.Devices,
+		Services: ts.Services,
+		Location: func(ip net.IP) string {
+			return "http://" + ip.String() + ":1338/virtual/other/rootDesc.xml"
+		},
+		ConfigID: 9,
+	}
+	ts.OtherDevices = []*Device{other}
+	ts.start()
+	ts.clock.advance(100 * time.Millisecond)
+	as := parseNotifies(t, ts.conn.receive(t, 8))
+	checkTypes(t, as, append(ts.allTypes(), other.allTypes()...))
+	for _, a := range as {
+		if (a.UUID() == "uuid:other") != (a.ConfigID == 9) || (a.ConfigID == 9) != strings.Contains(a.Location, "/virtual/other/") {
+			t.Fatalf("%+v", a)
+		}
+	}
+	// Searches for a device's UUID are answered for that device only.
+	sender := &net.UDPAddr{IP: net.IPv4(192, 168, 1, 9), Port: 40000}
+	ts.conn.deliver(mSearch("uuid:other", "1"), sender)
+	ts.clock.blockUntil(2)
+	ts.clock.advance(time.Second)
+	if as := parseResponses(t, ts.conn.receive(t, 1), sender); as[0].USN != "uuid:other" || as[0].ConfigID != 9 {
+		t.Fatalf("%+v", as[0])
+	}
+	// Reconfiguring a device only renews its advertisements.
+	if err := ts.ReconfigureDevice("uuid:other", 10); err != nil {
+		t.Fatal(err)
+	}
+	as = parseNotifies(t, ts.conn.receive(t, 4))
+	for _, a := range as {
+		if a.NTS != byebyeNTS || a.UUID() != "uuid:other" || a.ConfigID != 9 {
+			t.Fatalf("%+v", a)
+		}
+	}
+	ts.clock.advance(100 * time.Millisecond)
+	as = parseNotifies(t, ts.conn.receive(t, 4))
+	for _, a := range as {
+		if a.NTS != aliveNTS || a.UUID() != "uuid:other" || a.ConfigID != 10 {
+			t.Fatalf("%+v", a)
+		}
+	}
+	if err := ts.ReconfigureDevice("uuid:missing", 1); err == nil {
+		t.Fatal("reconfigured missing device")
+	}
+	ts.Close()
+	if err := <-ts.served; err != nil {
+		t.Fatal(err)
+	}
+}
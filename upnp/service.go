@@ -0,0 +1,333 @@
+package upnp
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// StateVariableDef declares a state variable of a service. Action arguments
+// take their type from one.
+type StateVariableDef struct {
+	Name string
+	// A UPnP data type, such as "string", "ui4" or "boolean". See UDA 1.1
+	// section 2.5.
+	DataType   string
+	SendEvents bool
+	// If not empty, the only values allowed.
+	AllowedValues []string
+	// If not nil, the inclusive bounds of a numeric variable.
+	AllowedRange *AllowedValueRange
+}
+
+// ArgumentDef declares an action argument.
+type ArgumentDef struct {
+	Name string
+	// The name of the state variable giving the argument's type.
+	StateVariable string
+}
+
+// Arg declares an argument typed by the named state variable.
+func Arg(name, stateVariable string) ArgumentDef {
+	return ArgumentDef{name, stateVariable}
+}
+
+// Args are action argument values by name.
+type Args map[string]string
+
+// Uint returns a numeric argument. In arguments are validated before the
+// action is called, so it can't fail for those.
+func (a Args) Uint(name string) uint64 {
+	i, _ := strconv.ParseUint(a[name], 10, 64)
+	return i
+}
+
+func (a Args) Int(name string) int64 {
+	i, _ := strconv.ParseInt(a[name], 10, 64)
+	return i
+}
+
+func (a Args) Bool(name string) bool {
+	b, _ := parseBoolean(a[name])
+	return b
+}
+
+// ActionFunc implements an action. It's passed the validated in arguments,
+// and returns a value for every out argument.
+type ActionFunc func(in Args, r *http.Request) (out Args, err error)
+
+// ActionDef declares an action and the function that implements it.
+type ActionDef struct {
+	Name string
+	In   []ArgumentDef
+	Out  []ArgumentDef
+	Func ActionFunc
+}
+
+// ServiceDef declares a service. The SCPD is generated from it, and action
+// requests are validated against it before they're dispatched.
+type ServiceDef struct {
+	Actions        []ActionDef
+	StateVariables []StateVariableDef
+}
+
+// ArgValue is an argument value in a SOAP action or response, where order
+// matters.
+type ArgValue struct {
+	Name  string
+	Value string
+}
+
+func (sd *ServiceDef) action(name string) *ActionDef {
+	for i := range sd.Actions {
+		if sd.Actions[i].Name == name {
+			return &sd.Actions[i]
+		}
+	}
+	return nil
+}
+
+func (sd *ServiceDef) stateVariable(name string) *StateVariableDef {
+	for i := range sd.StateVariables {
+		if sd.StateVariables[i].Name == name {
+			return &sd.StateVariables[i]
+		}
+	}
+	return nil
+}
+
+// Check returns an error if the definition is inconsistent, such as an
+// argument referring to an undeclared state variable.
+func (sd *ServiceDef) Check() error {
+	vars := make(map[string]bool)
+	for _, sv := range sd.StateVariables {
+		if vars[sv.Name] {
+			return fmt.Errorf("state variable %s declared twice", sv.Name)
+		}
+		vars[sv.Name] = true
+		if _, ok := dataTypeParsers[sv.DataType]; !ok {
+			return fmt.Errorf("state variable %s has unknown data type %q", sv.Name, sv.DataType)
+		}
+	}
+	actions := make(map[string]bool)
+	for _, a := range sd.Actions {
+		if actions[a.Name] {
+			return fmt.Errorf("action %s declared twice", a.Name)
+		}
+		actions[a.Name] = true
+		if a.Func == nil {
+			return fmt.Errorf("action %s has no func", a.Name)
+		}
+		args := make(map[string]bool)
+		for _, arg := range append(a.In[:len(a.In):len(a.In)], a.Out...) {
+			if args[arg.Name] {
+				return fmt.Errorf("action %s has argument %s twice", a.Name, arg.Name)
+			}
+			args[arg.Name] = true
+			if !vars[arg.StateVariable] {
+				return fmt.Errorf("action %s argument %s refers to undeclared state variable %s", a.Name, arg.Name, arg.StateVariable)
+			}
+		}
+	}
+	return nil
+}
+
+// SCPD returns the service description.
+func (sd *ServiceDef) SCPD() (ret SCPD) {
+	ret.SpecVersion = SpecVersion{Major: 1, Minor: 0}
+	for _, a := range sd.Actions {
+		action := Action{Name: a.Name}
+		for _, dir := range []struct {
+			name string
+			args []ArgumentDef
+		}{{"in", a.In}, {"out", a.Out}} {
+			for _, arg := range dir.args {
+				action.Arguments = append(action.Arguments, Argument{
+					Name:            arg.Name,
+					Direction:       dir.name,
+					RelatedStateVar: arg.StateVariable,
+				})
+			}
+		}
+		ret.ActionList = append(ret.ActionList, action)
+	}
+	for _, sv := range sd.StateVariables {
+		v := StateVariable{
+			SendEvents:   "no",
+			Name:         sv.Name,
+			DataType:     sv.DataType,
+			AllowedRange: sv.AllowedRange,
+		}
+		if sv.SendEvents {
+			v.SendEvents = "yes"
+		}
+		if len(sv.AllowedValues) != 0 {
+			allowed := append([]string(nil), sv.AllowedValues...)
+			v.AllowedValues = &allowed
+		}
+		ret.ServiceStateTable = append(ret.ServiceStateTable, v)
+	}
+	return
+}
+
+// MarshalSCPD returns the service description XML document.
+func (sd *ServiceDef) MarshalSCPD() ([]byte, error) {
+	b, err := xml.MarshalIndent(sd.SCPD(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// Handle validates the arguments of an action request body, calls the
+// action, and returns its out arguments in their declared order.
+func (sd *ServiceDef) Handle(action string, argsXML []byte, r *http.Request) ([]ArgValue, error) {
+	a := sd.action(action)
+	if a == nil {
+		return nil, InvalidActionError
+	}
+	var req struct {
+		Args []struct {
+			XMLName xml.Name
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	}
+	if err := xml.Unmarshal(argsXML, &req); err != nil {
+		return nil, Errorf(InvalidArgsErrorCode, "Invalid Args: %s", err)
+	}
+	given := make(Args, len(req.Args))
+	for _, arg := range req.Args {
+		given[arg.XMLName.Local] = arg.Value
+	}
+	in := make(Args, len(a.In))
+	// Arguments the action doesn't declare are ignored, as some control
+	// points send extras, and the order isn't enforced.
+	for _, arg := range a.In {
+		value, ok := given[arg.Name]
+		if !ok {
+			return nil, Errorf(InvalidArgsErrorCode, "Invalid Args: missing %s", arg.Name)
+		}
+		value, err := sd.stateVariable(arg.StateVariable).validate(value)
+		if err != nil {
+			err.Desc = arg.Name + ": " + err.Desc
+			return nil, err
+		}
+		in[arg.Name] = value
+	}
+	out, err := a.Func(in, r)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]ArgValue, 0, len(a.Out))
+	for _, arg := range a.Out {
+		value, ok := out[arg.Name]
+		if !ok {
+			return nil, Errorf(ActionFailedErrorCode, "%s returned no %s", action, arg.Name)
+		}
+		ret = append(ret, ArgValue{arg.Name, value})
+	}
+	return ret, nil
+}
+
+func parseBoolean(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "1", "true", "yes":
+		return true, nil
+	case "0", "false", "no":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q", s)
+}
+
+func intParser(bits int) func(string) (interface{}, error) {
+	return func(s string) (interface{}, error) {
+		return strconv.ParseInt(s, 10, bits)
+	}
+}
+
+func uintParser(bits int) func(string) (interface{}, error) {
+	return func(s string) (interface{}, error) {
+		return strconv.ParseUint(s, 10, bits)
+	}
+}
+
+func floatParser(s string) (interface{}, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+func anyParser(s string) (interface{}, error) {
+	return s, nil
+}
+
+// Parses values of each supported data type. Types that aren't checked
+// accept any string.
+var dataTypeParsers = map[string]func(string) (interface{}, error){
+	"ui1":        uintParser(8),
+	"ui2":        uintParser(16),
+	"ui4":        uintParser(32),
+	"ui8":        uintParser(64),
+	"i1":         intParser(8),
+	"i2":         intParser(16),
+	"i4":         intParser(32),
+	"i8":         intParser(64),
+	"int":        intParser(64),
+	"r4":         floatParser,
+	"r8":         floatParser,
+	"number":     floatParser,
+	"float":      floatParser,
+	"boolean":    func(s string) (interface{}, error) { return parseBoolean(s) },
+	"string":     anyParser,
+	"char":       anyParser,
+	"uri":        anyParser,
+	"uuid":       anyParser,
+	"date":       anyParser,
+	"dateTime":   anyParser,
+	"time":       anyParser,
+	"bin.base64": anyParser,
+	"bin.hex":    anyParser,
+}
+
+// Checks a value against the variable's type and allowed values, returning
+// it with surrounding whitespace removed from numbers.
+func (sv *StateVariableDef) validate(s string) (string, *Error) {
+	parse := dataTypeParsers[sv.DataType]
+	if parse == nil {
+		parse = anyParser
+	}
+	if sv.DataType != "string" {
+		s = strings.TrimSpace(s)
+	}
+	v, err := parse(s)
+	if err != nil {
+		return "", Errorf(InvalidArgsErrorCode, "Invalid Args: %s", err)
+	}
+	if len(sv.AllowedValues) != 0 {
+		allowed := false
+		for _, a := range sv.AllowedValues {
+			if a == s {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return "", Errorf(ArgumentValueInvalidErrorCode, "value %q not allowed", s)
+		}
+	}
+	if r := sv.AllowedRange; r != nil {
+		var f float64
+		switch n := v.(type) {
+		case int64:
+			f = float64(n)
+		case uint64:
+			f = float64(n)
+		case float64:
+			f = n
+		}
+		if f < r.Minimum || f > r.Maximum {
+			return "", Errorf(ArgumentValueOutOfRangeErrorCode, "value %s out of range [%v, %v]", s, r.Minimum, r.Maximum)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,133 @@
+package upnp
+
+import (
+	"encoding/xml"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func testServiceDef() *ServiceDef {
+	return &ServiceDef{
+		Actions: []ActionDef{
+			{
+				Name: "SetVolume",
+				In: []ArgumentDef{
+					Arg("InstanceID", "A_ARG_TYPE_InstanceID"),
+					Arg("Channel", "A_ARG_TYPE_Channel"),
+					Arg("DesiredVolume", "Volume"),
+				},
+				Func: func(in Args, r *http.Request) (Args, error) {
+					return Args{}, nil
+				},
+			},
+			{
+				Name: "GetVolume",
+				In:   []ArgumentDef{Arg("InstanceID", "A_ARG_TYPE_InstanceID")},
+				Out: []ArgumentDef{
+					Arg("CurrentVolume", "Volume"),
+					Arg("Mute", "Mute"),
+				},
+				Func: func(in Args, r *http.Request) (Args, error) {
+					return Args{"Mute": "0", "CurrentVolume": "42"}, nil
+				},
+			},
+			{
+				Name: "Broken",
+				Out:  []ArgumentDef{Arg("Mute", "Mute")},
+				Func: func(in Args, r *http.Request) (Args, error) {
+					return nil, nil
+				},
+			},
+		},
+		StateVariables: []StateVariableDef{
+			{Name: "Volume", DataType: "ui2", SendEvents: true, AllowedRange: &AllowedValueRange{Minimum: 0, Maximum: 100, Step: 1}},
+			{Name: "Mute", DataType: "boolean"},
+			{Name: "A_ARG_TYPE_InstanceID", DataType: "ui4"},
+			{Name: "A_ARG_TYPE_Channel", DataType: "string", AllowedValues: []string{"Master"}},
+		},
+	}
+}
+
+func TestServiceDefCheck(t *testing.T) {
+	sd := testServiceDef()
+	if err := sd.Check(); err != nil {
+		t.Fatal(err)
+	}
+	sd.Actions[0].In[0].StateVariable = "Nope"
+	if sd.Check() == nil {
+		t.Fatal("undeclared state variable not reported")
+	}
+	sd = testServiceDef()
+	sd.StateVariables[0].DataType = "ui3"
+	if sd.Check() == nil {
+		t.Fatal("unknown data type not reported")
+	}
+}
+
+func TestServiceDefSCPD(t *testing.T) {
+	b, err := testServiceDef().MarshalSCPD()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scpd SCPD
+	if err := xml.Unmarshal(b, &scpd); err != nil {
+		t.Fatal(err)
+	}
+	if len(scpd.ActionList) != 3 || scpd.ActionList[1].Name != "GetVolume" {
+		t.Fatalf("%+v", scpd.ActionList)
+	}
+	if got := scpd.ActionList[1].Arguments; !reflect.DeepEqual(got, []Argument{
+		{"InstanceID", "in", "A_ARG_TYPE_InstanceID"},
+		{"CurrentVolume", "out", "Volume"},
+		{"Mute", "out", "Mute"},
+	}) {
+		t.Fatalf("%+v", got)
+	}
+	v := scpd.ServiceStateTable[0]
+	if v.SendEvents != "yes" || v.AllowedRange == nil || v.AllowedRange.Maximum != 100 {
+		t.Fatalf("%+v", v)
+	}
+	if c := scpd.ServiceStateTable[3]; c.AllowedValues == nil || (*c.AllowedValues)[0] != "Master" {
+		t.Fatalf("%+v", c)
+	}
+}
+
+func expectErrorCode(t *testing.T, err error, code uint) {
+	t.Helper()
+	e, ok := err.(*Error)
+	if !ok || e.Code != code {
+		t.Fatalf("expected error %d, got %v", code, err)
+	}
+}
+
+func TestServiceDefHandle(t *testing.T) {
+	sd := testServiceDef()
+	handle := func(action, args string) ([]ArgValue, error) {
+		return sd.Handle(action, []byte(`<u:`+action+` xmlns:u="urn:schemas-upnp-org:service:RenderingControl:1">`+args+`</u:`+action+`>`), nil)
+	}
+	out, err := handle("GetVolume", "<InstanceID>0</InstanceID>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Out arguments come in declared order.
+	if !reflect.DeepEqual(out, []ArgValue{{"CurrentVolume", "42"}, {"Mute", "0"}}) {
+		t.Fatal(out)
+	}
+	_, err = handle("SetVolume", "<DesiredVolume> 10 </DesiredVolume><InstanceID>0</InstanceID><Channel>Master</Channel><Extra/>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = handle("SetVolume", "<InstanceID>0</InstanceID><Channel>Master</Channel>")
+	expectErrorCode(t, err, InvalidArgsErrorCode)
+	_, err = handle("SetVolume", "<InstanceID>-1</InstanceID><Channel>Master</Channel><DesiredVolume>1</DesiredVolume>")
+	expectErrorCode(t, err, InvalidArgsErrorCode)
+	_, err = handle("SetVolume", "<InstanceID>0</InstanceID><Channel>LF</Channel><DesiredVolume>1</DesiredVolume>")
+	expectErrorCode(t, err, ArgumentValueInvalidErrorCode)
+	_, err = handle("SetVolume", "<InstanceID>0</InstanceID><Channel>Master</Channel><DesiredVolume>101</DesiredVolume>")
+	expectErrorCode(t, err, ArgumentValueOutOfRangeErrorCode)
+	_, err = handle("Fly", "")
+	expectErrorCode(t, err, InvalidActionErrorCode)
+	_, err = handle("Broken", "")
+	expectErrorCode(t, err, ActionFailedErrorCode)
+}
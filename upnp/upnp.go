@@ -84,12 +84,18 @@ type Device struct {
 	UDN          string
 	IconList     []Icon    `xml:"iconList>icon"`
 	ServiceList  []Service `xml:"serviceList>service"`
+	// Embedded devices.
+	DeviceList []Device `xml:"deviceList>device"`
 }
 
 type DeviceDesc struct {
 	XMLName     xml.Name    `xml:"urn:schemas-upnp-org:device-1-0 root"`
+	ConfigID    uint32      `xml:"configId,attr,omitempty"`
 	SpecVersion SpecVersion `xml:"specVersion"`
-	Device      Device      `xml:"device"`
+	// Relative URLs are resolved against this, if it's set. Deprecated in
+	// UDA 1.1, but still sent by some devices.
+	URLBase string `xml:"URLBase,omitempty"`
+	Device  Device `xml:"device"`
 }
 
 type Error struct {
@@ -103,9 +109,11 @@ func (e *Error) Error() string {
 }
 
 const (
-	InvalidActionErrorCode        = 401
-	ActionFailedErrorCode         = 501
-	ArgumentValueInvalidErrorCode = 600
+	InvalidActionErrorCode           = 401
+	InvalidArgsErrorCode             = 402
+	ActionFailedErrorCode            = 501
+	ArgumentValueInvalidErrorCode    = 600
+	ArgumentValueOutOfRangeErrorCode = 601
 )
 
 var (
@@ -130,14 +138,14 @@ func ConvertError(err error) *Error {
 }
 
 type Action struct {
-	Name      string
-	Arguments []Argument
+	Name      string     `xml:"name"`
+	Arguments []Argument `xml:"argumentList>argument,omitempty"`
 }
 
 type Argument struct {
-	Name            string
-	Direction       string
-	RelatedStateVar string
+	Name            string `xml:"name"`
+	Direction       string `xml:"direction"`
+	RelatedStateVar string `xml:"relatedStateVariable"`
 }
 
 type SCPD struct {
@@ -148,10 +156,17 @@ type SCPD struct {
 }
 
 type StateVariable struct {
-	SendEvents    string    `xml:"sendEvents,attr"`
-	Name          string    `xml:"name"`
-	DataType      string    `xml:"dataType"`
-	AllowedValues *[]string `xml:"allowedValueList>allowedValue,omitempty"`
+	SendEvents    string             `xml:"sendEvents,attr"`
+	Name          string             `xml:"name"`
+	DataType      string             `xml:"dataType"`
+	AllowedValues *[]string          `xml:"allowedValueList>allowedValue,omitempty"`
+	AllowedRange  *AllowedValueRange `xml:"allowedValueRange,omitempty"`
+}
+
+type AllowedValueRange struct {
+	Minimum float64 `xml:"minimum"`
+	Maximum float64 `xml:"maximum"`
+	Step    float64 `xml:"step,omitempty"`
 }
 
 func FormatUUID(buf []byte) string {
@@ -0,0 +1,227 @@
+package upnpav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+)
+
+const (
+	DIDLLiteNamespace = "urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/"
+	DCNamespace       = "http://purl.org/dc/elements/1.1/"
+	UPnPNamespace     = "urn:schemas-upnp-org:metadata-1-0/upnp/"
+	DLNANamespace     = "urn:schemas-dlna-org:metadata-1-0/"
+	// Samsung's extensions, such as CaptionInfoEx.
+	SecNamespace = "http://www.sec.co.kr/"
+)
+
+// DIDLLite is a DIDL-Lite document, such as a Browse result.
+type DIDLLite struct {
+	// Container and Item values, in document order.
+	Objects []interface{}
+	// desc elements outside any object.
+	Descs []Desc
+}
+
+// Containers returns the document's containers.
+func (d DIDLLite) Containers() (ret []Container) {
+	for _, o := range d.Objects {
+		if c, ok := o.(Container); ok {
+			ret = append(ret, c)
+		}
+	}
+	return
+}
+
+// Items returns the document's items.
+func (d DIDLLite) Items() (ret []Item) {
+	for _, o := range d.Objects {
+		if i, ok := o.(Item); ok {
+			ret = append(ret, i)
+		}
+	}
+	return
+}
+
+func (d DIDLLite) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	// The namespaces are declared with their conventional prefixes, which
+	// the field tags use.
+	start = xml.StartElement{
+		Name: xml.Name{Local: "DIDL-Lite"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns:dc"}, Value: DCNamespace},
+			{Name: xml.Name{Local: "xmlns:upnp"}, Value: UPnPNamespace},
+			{Name: xml.Name{Local: "xmlns"}, Value: DIDLLiteNamespace},
+			{Name: xml.Name{Local: "xmlns:dlna"}, Value: DLNANamespace},
+			{Name: xml.Name{Local: "xmlns:sec"}, Value: SecNamespace},
+		},
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, o := range d.Objects {
+		switch o.(type) {
+		case Container, *Container, Item, *Item:
+		default:
+			return fmt.Errorf("unexpected DIDL-Lite object type %T", o)
+		}
+		if err := e.Encode(o); err != nil {
+			return err
+		}
+	}
+	for _, desc := range d.Descs {
+		if err := e.EncodeElement(desc, xml.StartElement{Name: xml.Name{Local: "desc"}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+func (d *DIDLLite) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	for {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "container":
+				var c Container
+				if err := dec.DecodeElement(&c, &t); err != nil {
+					return err
+				}
+				d.Objects = append(d.Objects, c)
+			case "item":
+				var i Item
+				if err := dec.DecodeElement(&i, &t); err != nil {
+					return err
+				}
+				d.Objects = append(d.Objects, i)
+			case "desc":
+				var desc Desc
+				if err := dec.DecodeElement(&desc, &t); err != nil {
+					return err
+				}
+				d.Descs = append(d.Descs, desc)
+			default:
+				if err := dec.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// Captures the raw content, with the element names from the prefixing
+// token reader.
+func (desc *Desc) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	for _, a := range start.Attr {
+		switch a.Name.Local {
+		case "id":
+			desc.ID = a.Value
+		case "nameSpace":
+			desc.NameSpace = a.Value
+		case "type":
+			desc.Type = a.Value
+		}
+	}
+	var buf bytes.Buffer
+	e := xml.NewEncoder(&buf)
+	depth := 0
+	for {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			if depth == 0 {
+				if err := e.Flush(); err != nil {
+					return err
+				}
+				desc.Content = buf.String()
+				return nil
+			}
+			depth--
+		case xml.ProcInst, xml.Directive:
+			continue
+		}
+		if err := e.EncodeToken(t); err != nil {
+			return err
+		}
+	}
+}
+
+// MarshalDIDLLite returns a DIDL-Lite document of the given Container and
+// Item values.
+func MarshalDIDLLite(objs ...interface{}) ([]byte, error) {
+	return xml.Marshal(DIDLLite{Objects: objs})
+}
+
+// UnmarshalDIDLLite parses a DIDL-Lite document. Elements may use any
+// prefixes for the DIDL-Lite namespaces, and commonly undeclared ones are
+// tolerated.
+func UnmarshalDIDLLite(b []byte) (d DIDLLite, err error) {
+	dec := xml.NewTokenDecoder(prefixingReader{xml.NewDecoder(bytes.NewReader(b))})
+	err = dec.Decode(&d)
+	return
+}
+
+// The prefixes the field tags use, by namespace. The prefixes themselves are
+// included for documents that use them without declaring them.
+var namespacePrefixes = map[string]string{
+	DIDLLiteNamespace: "",
+	DCNamespace:       "dc",
+	UPnPNamespace:     "upnp",
+	DLNANamespace:     "dlna",
+	SecNamespace:      "sec",
+	"dc":              "dc",
+	"upnp":            "upnp",
+	"dlna":            "dlna",
+	"sec":             "sec",
+}
+
+// Rewrites names in the DIDL-Lite namespaces to the prefixed local names
+// the field tags use, as encoding/xml can't map namespaces to prefixes.
+// Namespace declarations are dropped.
+type prefixingReader struct {
+	d *xml.Decoder
+}
+
+func prefixed(n xml.Name) xml.Name {
+	p, ok := namespacePrefixes[n.Space]
+	if !ok || n.Space == "" {
+		return n
+	}
+	if p == "" {
+		return xml.Name{Local: n.Local}
+	}
+	return xml.Name{Local: p + ":" + n.Local}
+}
+
+func (r prefixingReader) Token() (xml.Token, error) {
+	t, err := r.d.Token()
+	switch v := t.(type) {
+	case xml.StartElement:
+		v.Name = prefixed(v.Name)
+		attrs := make([]xml.Attr, 0, len(v.Attr))
+		for _, a := range v.Attr {
+			if a.Name.Space == "xmlns" || a.Name.Space == "" && a.Name.Local == "xmlns" {
+				continue
+			}
+			a.Name = prefixed(a.Name)
+			attrs = append(attrs, a)
+		}
+		v.Attr = attrs
+		t = v
+	case xml.EndElement:
+		v.Name = prefixed(v.Name)
+		t = v
+	}
+	return t, err
+}
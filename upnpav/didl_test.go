@@ -0,0 +1,249 @@
+package upnpav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func readDIDLLite(t *testing.T, name string) DIDLLite {
+	b, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := UnmarshalDIDLLite(b)
+	if err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+	return d
+}
+
+// Returns the elements of a DIDL-Lite document, one per line with their
+// path, attributes and text, sorted. Documents compare equal if they have
+// the same content in any order, with any prefixes, and with or without
+// attributes that have default values.
+func canonicalDIDLLite(t *testing.T, b []byte) (ret []string) {
+	// Undeclared prefixes are left as the name's space.
+	spaces := make(map[string]string)
+	for space, prefix := range namespacePrefixes {
+		if prefix != "" && prefix != space {
+			spaces[prefix] = space
+		}
+	}
+	name := func(n xml.Name) string {
+		if space, ok := spaces[n.Space]; ok {
+			n.Space = space
+		}
+		return n.Space + " " + n.Local
+	}
+	type element struct {
+		path  string
+		attrs []string
+		text  strings.Builder
+	}
+	var stack []*element
+	dec := xml.NewDecoder(bytes.NewReader(b))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			e := &element{path: name(tok.Name)}
+			if len(stack) != 0 {
+				e.path = stack[len(stack)-1].path + " > " + e.path
+			}
+			for _, a := range tok.Attr {
+				if a.Name.Space == "xmlns" || a.Name.Space == "" && a.Name.Local == "xmlns" {
+					continue
+				}
+				v := a.Value
+				// Some servers write booleans as words.
+				switch v {
+				case "true":
+					v = "1"
+				case "false":
+					v = "0"
+				}
+				if v == "0" || v == "" {
+					continue
+				}
+				e.attrs = append(e.attrs, name(a.Name)+"="+v)
+			}
+			sort.Strings(e.attrs)
+			stack = append(stack, e)
+		case xml.CharData:
+			if len(stack) != 0 {
+				stack[len(stack)-1].text.Write(tok)
+			}
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			ret = append(ret, fmt.Sprintf("%s %q %q", e.path, e.attrs, strings.TrimSpace(e.text.String())))
+		}
+	}
+	sort.Strings(ret)
+	return
+}
+
+// Documents in the styles of other servers parse, and marshal back to the
+// same content, so nothing in them is dropped.
+func TestDIDLLiteRoundTrip(t *testing.T) {
+	names, err := filepath.Glob(filepath.Join("testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) == 0 {
+		t.Fatal("no test documents")
+	}
+	for _, name := range names {
+		src, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := readDIDLLite(t, filepath.Base(name))
+		if len(d.Objects) == 0 {
+			t.Errorf("%s: no objects", name)
+		}
+		b, err := xml.Marshal(d)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		want, got := canonicalDIDLLite(t, src), canonicalDIDLLite(t, b)
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("%s: round trip differs:\n%s\n\n%s", name, strings.Join(want, "\n"), strings.Join(got, "\n"))
+		}
+	}
+}
+
+func TestDIDLLiteMusicTrack(t *testing.T) {
+	items := readDIDLLite(t, "minidlna-music.xml").Items()
+	if len(items) != 1 {
+		t.Fatalf("got %d items", len(items))
+	}
+	i := items[0]
+	if i.ID != "64$0$0" || i.ParentID != "64$0" || i.RefID != "1$4$0" || i.Restricted != 1 {
+		t.Errorf("bad attributes: %#v", i)
+	}
+	if i.Title != "So What" || i.Class != "object.item.audioItem.musicTrack" || i.Album != "Kind of Blue" {
+		t.Errorf("bad properties: %#v", i.Object)
+	}
+	if i.Creator != "Miles Davis" || i.Date != "1959-01-01" || i.OriginalTrackNumber != 1 {
+		t.Errorf("bad properties: %#v", i.Object)
+	}
+	if !reflect.DeepEqual(i.Artist, []Person{{"Miles Davis", ""}, {"Miles Davis", "Composer"}}) {
+		t.Errorf("bad artists: %#v", i.Artist)
+	}
+	if !reflect.DeepEqual(i.AlbumArtURI, []AlbumArt{{"http://192.168.1.2:8200/AlbumArt/20-1.jpg", "JPEG_TN"}}) {
+		t.Errorf("bad album art: %#v", i.AlbumArtURI)
+	}
+	if len(i.Res) != 2 {
+		t.Fatalf("got %d res", len(i.Res))
+	}
+	r := i.Res[1]
+	if r.URL != "http://192.168.1.2:8200/MediaItems/1.mp3" || r.Size != 2250000 || r.Duration != "0:09:22.000" ||
+		r.Bitrate != 4000 || r.SampleFrequency != 44100 || r.BitsPerSample != 16 || r.NrAudioChannels != 2 {
+		t.Errorf("bad res: %#v", r)
+	}
+}
+
+func TestDIDLLiteMovie(t *testing.T) {
+	i := readDIDLLite(t, "plex-movie.xml").Items()[0]
+	if !strings.HasPrefix(i.LongDescription, "Pulp novelist Holly Martins travels to shadowy") {
+		t.Errorf("bad long description: %q", i.LongDescription)
+	}
+	if i.Description == "" || i.Rating != "Not Rated" || i.Date != "1949-09-01T00:00:00" {
+		t.Errorf("bad properties: %#v", i.Object)
+	}
+	if !reflect.DeepEqual(i.Actor, []Person{{"Joseph Cotten", "Holly Martins"}, {"Orson Welles", "Harry Lime"}}) {
+		t.Errorf("bad actors: %#v", i.Actor)
+	}
+	if !reflect.DeepEqual(i.Genre, []string{"Film-Noir", "Mystery"}) {
+		t.Errorf("bad genres: %#v", i.Genre)
+	}
+	if len(i.Res) != 3 || i.Res[0].Resolution != "1920x1080" {
+		t.Errorf("bad res: %#v", i.Res)
+	}
+	if len(i.Descs) != 1 {
+		t.Fatalf("got %d descs", len(i.Descs))
+	}
+	if d := i.Descs[0]; d.ID != "plex" || d.NameSpace != "urn:schemas-plex-tv:metadata" || !strings.Contains(d.Content, ">2501</ratingKey>") {
+		t.Errorf("bad desc: %#v", d)
+	}
+}
+
+func TestDIDLLiteContainers(t *testing.T) {
+	d := readDIDLLite(t, "serviio-containers.xml")
+	cs := d.Containers()
+	if len(cs) != 2 || len(d.Items()) != 1 {
+		t.Fatalf("got %d containers and %d items", len(cs), len(d.Items()))
+	}
+	c := cs[0]
+	if c.ChildCount != 12 || c.Searchable != 1 || c.StorageMedium != "HDD" || c.WriteStatus != "NOT_WRITABLE" {
+		t.Errorf("bad container: %#v", c)
+	}
+	if !reflect.DeepEqual(c.SearchClasses, []ClassRef{{"object.item.videoItem", 1}}) || !reflect.DeepEqual(c.CreateClasses, []ClassRef{{"object.item.videoItem", 0}}) {
+		t.Errorf("bad classes: %#v, %#v", c.SearchClasses, c.CreateClasses)
+	}
+	// Objects are kept in document order.
+	if _, ok := d.Objects[2].(Item); !ok {
+		t.Errorf("expected item last, got %T", d.Objects[2])
+	}
+	i := d.Items()[0]
+	if len(i.Author) != 2 || i.Author[1].Role != "Lyricist" || i.OriginalDiscNumber != 1 || i.Res[0].Protection != "none" || i.DcmInfo != "CREATIONDATE=0" {
+		t.Errorf("bad item: %#v", i)
+	}
+}
+
+// Undeclared prefixes and "true" booleans are tolerated.
+func TestDIDLLiteUndeclaredPrefixes(t *testing.T) {
+	d := readDIDLLite(t, "wmp-tv.xml")
+	i := d.Items()[0]
+	if i.Title != "Evening News" || i.Class != "object.item.videoItem.videoBroadcast" || i.Restricted != 1 {
+		t.Errorf("bad item: %#v", i.Object)
+	}
+	if i.ChannelName != "BBC One" || i.ChannelNr != 1 || i.ScheduledEndTime != "2016-05-01T18:30:00" {
+		t.Errorf("bad broadcast properties: %#v", i.Object)
+	}
+	if i.Res[0].ImportURI == "" || i.Res[0].ColorDepth != 24 {
+		t.Errorf("bad res: %#v", i.Res[0])
+	}
+	if len(d.Descs) != 1 || d.Descs[0].Content != "total=1" {
+		t.Errorf("bad top-level descs: %#v", d.Descs)
+	}
+}
+
+func TestDIDLLiteCaptionInfo(t *testing.T) {
+	i := Item{Object: Object{ID: "1", Title: "a"}, CaptionInfoEx: []CaptionInfo{{URL: "http://dms/a.srt", Type: "srt"}}}
+	b, err := MarshalDIDLLite(i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `<sec:CaptionInfoEx sec:type="srt">http://dms/a.srt</sec:CaptionInfoEx>`) {
+		t.Fatalf("no caption info in %s", b)
+	}
+	d, err := UnmarshalDIDLLite(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if items := d.Items(); len(items) != 1 || !reflect.DeepEqual(items[0].CaptionInfoEx, i.CaptionInfoEx) {
+		t.Fatalf("caption info lost: %#v", d.Objects)
+	}
+}
+
+func TestMarshalDIDLLiteRejectsOtherTypes(t *testing.T) {
+	if _, err := MarshalDIDLLite(Object{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
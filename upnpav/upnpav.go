@@ -2,44 +2,144 @@ package upnpav
 
 import (
 	"encoding/xml"
+	"fmt"
+	"strings"
 )
 
 const (
-	NoSuchObjectErrorCode = 701
+	NoSuchObjectErrorCode        = 701
+	InvalidSortCriteriaErrorCode = 709
 )
 
+// A boolean attribute. It's written as 0 or 1, and "true" and "false" are
+// accepted too, as some servers use them.
+type Boolean int
+
+func (b *Boolean) UnmarshalXMLAttr(attr xml.Attr) error {
+	switch strings.ToLower(strings.TrimSpace(attr.Value)) {
+	case "1", "true":
+		*b = 1
+	case "0", "false", "":
+		*b = 0
+	default:
+		return fmt.Errorf("invalid boolean %s=%q", attr.Name.Local, attr.Value)
+	}
+	return nil
+}
+
+// A res element. Attributes are as in the ContentDirectory:1 spec, section
+// 2.8.2.
 type Resource struct {
-	XMLName      xml.Name `xml:"res"`
-	ProtocolInfo string   `xml:"protocolInfo,attr"`
-	URL          string   `xml:",chardata"`
-	Size         uint64   `xml:"size,attr,omitempty"`
-	Bitrate      uint     `xml:"bitrate,attr,omitempty"`
-	Duration     string   `xml:"duration,attr,omitempty"`
-	Resolution   string   `xml:"resolution,attr,omitempty"`
+	XMLName         xml.Name `xml:"res"`
+	ProtocolInfo    string   `xml:"protocolInfo,attr"`
+	URL             string   `xml:",chardata"`
+	ImportURI       string   `xml:"importUri,attr,omitempty"`
+	Size            uint64   `xml:"size,attr,omitempty"`
+	Bitrate         uint     `xml:"bitrate,attr,omitempty"`
+	Duration        string   `xml:"duration,attr,omitempty"`
+	Resolution      string   `xml:"resolution,attr,omitempty"`
+	SampleFrequency uint     `xml:"sampleFrequency,attr,omitempty"`
+	BitsPerSample   uint     `xml:"bitsPerSample,attr,omitempty"`
+	NrAudioChannels uint     `xml:"nrAudioChannels,attr,omitempty"`
+	ColorDepth      uint     `xml:"colorDepth,attr,omitempty"`
+	Protection      string   `xml:"protection,attr,omitempty"`
 }
 
 type Container struct {
 	Object
 	XMLName    xml.Name `xml:"container"`
 	ChildCount int      `xml:"childCount,attr"`
+	// Classes that can be searched for, or created, in the container.
+	SearchClasses []ClassRef `xml:"upnp:searchClass,omitempty"`
+	CreateClasses []ClassRef `xml:"upnp:createClass,omitempty"`
+}
+
+// A searchClass or createClass element. With IncludeDerived, the classes
+// derived from the Class are included too.
+type ClassRef struct {
+	Class          string  `xml:",chardata"`
+	IncludeDerived Boolean `xml:"includeDerived,attr"`
 }
 
 type Item struct {
 	Object
 	XMLName xml.Name `xml:"item"`
-	Res     []Resource
+	// The ID of the item this one refers to, if it's a reference.
+	RefID string     `xml:"refID,attr,omitempty"`
+	Res   []Resource `xml:"res"`
+	// Subtitles for Samsung devices, which don't look for them in res.
+	CaptionInfoEx []CaptionInfo `xml:"sec:CaptionInfoEx,omitempty"`
+	// Samsung's bookmark and creation date, such as "CREATIONDATE=0".
+	DcmInfo string `xml:"sec:dcmInfo,omitempty"`
+}
+
+// A sec:CaptionInfoEx element, the URL of a subtitle file of the Type, such
+// as "srt".
+type CaptionInfo struct {
+	URL  string `xml:",chardata"`
+	Type string `xml:"sec:type,attr"`
+}
+
+// An albumArtURI element. ProfileID is the image's DLNA profile, such as
+// "JPEG_TN".
+type AlbumArt struct {
+	URI       string `xml:",chardata"`
+	ProfileID string `xml:"dlna:profileID,attr,omitempty"`
+}
+
+// A person, such as an artist or actor, with an optional role like
+// "Composer".
+type Person struct {
+	Name string `xml:",chardata"`
+	Role string `xml:"role,attr,omitempty"`
+}
+
+// A desc element, which carries metadata from other schemas. Content is the
+// raw XML.
+type Desc struct {
+	ID        string `xml:"id,attr"`
+	NameSpace string `xml:"nameSpace,attr"`
+	Type      string `xml:"type,attr,omitempty"`
+	Content   string `xml:",innerxml"`
 }
 
 type Object struct {
-	ID          string `xml:"id,attr"`
-	ParentID    string `xml:"parentID,attr"`
-	Restricted  int    `xml:"restricted,attr"` // indicates whether the object is modifiable
-	Class       string `xml:"upnp:class"`
-	Icon        string `xml:"upnp:icon,omitempty"`
-	Title       string `xml:"dc:title"`
-	Artist      string `xml:"upnp:artist,omitempty"`
-	Album       string `xml:"upnp:album,omitempty"`
-	Genre       string `xml:"upnp:genre,omitempty"`
-	AlbumArtURI string `xml:"upnp:albumArtURI,omitempty"`
-	Searchable  int    `xml:"searchable,attr"`
+	ID         string   `xml:"id,attr"`
+	ParentID   string   `xml:"parentID,attr"`
+	Restricted Boolean  `xml:"restricted,attr"` // indicates whether the object is modifiable
+	Class      string   `xml:"upnp:class"`
+	Icon       string   `xml:"upnp:icon,omitempty"`
+	Title      string   `xml:"dc:title"`
+	Artist     []Person `xml:"upnp:artist,omitempty"`
+	Album      string   `xml:"upnp:album,omitempty"`
+	Genre      []string `xml:"upnp:genre,omitempty"`
+	// Several sizes or formats may be given.
+	AlbumArtURI []AlbumArt `xml:"upnp:albumArtURI,omitempty"`
+	Searchable  Boolean    `xml:"searchable,attr"`
+	// Object status, such as "WRITABLE" or "NOT_WRITABLE".
+	WriteStatus         string   `xml:"upnp:writeStatus,omitempty"`
+	Creator             string   `xml:"dc:creator,omitempty"`
+	Date                string   `xml:"dc:date,omitempty"`
+	Description         string   `xml:"dc:description,omitempty"`
+	LongDescription     string   `xml:"upnp:longDescription,omitempty"`
+	Publisher           []string `xml:"dc:publisher,omitempty"`
+	Contributor         []string `xml:"dc:contributor,omitempty"`
+	Language            []string `xml:"dc:language,omitempty"`
+	Rights              []string `xml:"dc:rights,omitempty"`
+	Actor               []Person `xml:"upnp:actor,omitempty"`
+	Author              []Person `xml:"upnp:author,omitempty"`
+	Producer            []string `xml:"upnp:producer,omitempty"`
+	Director            []string `xml:"upnp:director,omitempty"`
+	Rating              string   `xml:"upnp:rating,omitempty"`
+	OriginalTrackNumber int      `xml:"upnp:originalTrackNumber,omitempty"`
+	OriginalDiscNumber  int      `xml:"upnp:originalDiscNumber,omitempty"`
+	Playlist            []string `xml:"upnp:playlist,omitempty"`
+	StorageMedium       string   `xml:"upnp:storageMedium,omitempty"`
+	Region              string   `xml:"upnp:region,omitempty"`
+	ChannelName         string   `xml:"upnp:channelName,omitempty"`
+	ChannelNr           int      `xml:"upnp:channelNr,omitempty"`
+	// Schedule of broadcast items.
+	ScheduledStartTime string `xml:"upnp:scheduledStartTime,omitempty"`
+	ScheduledEndTime   string `xml:"upnp:scheduledEndTime,omitempty"`
+	Descs              []Desc `xml:"desc,omitempty"`
 }